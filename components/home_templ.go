@@ -14,26 +14,72 @@ import (
 	"strings"
 )
 
-var FirstSelection = map[services.UnitType]map[services.Unit]string{
-	services.Temperature: {
-		services.Celsius:    "celsius",
-		services.Fahrenheit: "fahrenheit",
-		services.Kelvin:     "kelvin",
-	},
-	services.Length: {
-		services.Meters:     "meters",
-		services.Kilometers: "kilometers",
-		services.Feet:       "feet",
-		services.Yards:      "yards",
-		services.Miles:      "miles",
-	},
-	services.Weight: {
-		services.Milligrams: "milligrams",
-		services.Grams:      "grams",
-		services.Kilograms:  "kilograms",
-		services.Ounces:     "ounces",
-		services.Pounds:     "pounds",
-	},
+// FirstSelection lists the units offered for each unit type, prefixed units
+// included, from the smallest to the largest
+var FirstSelection = unitSelections(services.Temperature, services.TemperatureDifference, services.Length, services.Weight, services.Area, services.Volume, services.Speed, services.Time, services.Data, services.DataRate, services.Pressure, services.Energy, services.Power, services.Angle, services.FuelEconomy, services.ShoeSize, services.RingSize)
+
+// CookingTab converts between the volume and the weight of an ingredient
+const CookingTab = "cooking"
+
+// CookingUnits are the kitchen volumes and weights offered by the cooking tab
+var CookingUnits = []string{
+	string(services.USTeaspoons), string(services.USTablespoons), string(services.USFluidOunces), string(services.USCups),
+	string(services.Milliliters), string(services.Liters),
+	string(services.Grams), string(services.Kilograms), string(services.Ounces), string(services.Pounds),
+}
+
+// TypographyTab converts print and screen lengths, which may depend on the
+// parameters of TypographyParameters
+const TypographyTab = "typography"
+
+// TypographyUnits are the lengths offered by the typography tab
+var TypographyUnits = []string{
+	string(services.Points), string(services.Picas), string(services.Pixels), string(services.Ems), string(services.Rems),
+	string(services.DensityIndependentPixels), string(services.ScaleIndependentPixels),
+	string(services.Inches), string(services.Millimeters),
+}
+
+// ParameterInput is a form field for a context parameter of the conversion
+type ParameterInput struct {
+	Parameter services.Parameter
+	Label     string
+	// Model is the key of the field in the store
+	Model string
+}
+
+// TypographyParameters are the parameters asked by the typography tab
+var TypographyParameters = []ParameterInput{
+	{Parameter: services.DPI, Label: "Screen resolution (dpi)", Model: "dpi"},
+	{Parameter: services.FontSize, Label: "Font size (px)", Model: "fontSize"},
+	{Parameter: services.RootFontSize, Label: "Root font size (px)", Model: "rootFontSize"},
+	{Parameter: services.Density, Label: "Android density (px per dp)", Model: "density"},
+	{Parameter: services.FontScale, Label: "Android font scale (dp per sp)", Model: "fontScale"},
+}
+
+// selection lists the units offered by a tab
+func selection(unitType string) []string {
+	switch unitType {
+	case CookingTab:
+		return CookingUnits
+	case TypographyTab:
+		return TypographyUnits
+	}
+	return FirstSelection[services.UnitType(strings.ToLower(unitType))]
+}
+
+// unitSelections lists the units of each unit type. Units that depend on
+// context parameters are left to the typography tab, the only one asking for them.
+func unitSelections(unitTypes ...services.UnitType) map[services.UnitType][]string {
+	selections := make(map[services.UnitType][]string, len(unitTypes))
+	for _, unitType := range unitTypes {
+		for _, unit := range services.Units(unitType) {
+			if definition, _ := services.Definition(unitType, unit); len(definition.Parameters) > 0 {
+				continue
+			}
+			selections[unitType] = append(selections[unitType], string(unit))
+		}
+	}
+	return selections
 }
 
 func Home() templ.Component {
@@ -77,7 +123,7 @@ func Home() templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			templ_7745c5c3_Err = TabNav(&Store{UnitType: "length", UnitToConvertFrom: "meters", UnitToConvertTo: "miles"}, TabForm("length")).Render(ctx, templ_7745c5c3_Buffer)
+			templ_7745c5c3_Err = TabNav(&Store{UnitType: "length", UnitToConvertFrom: "meters", UnitToConvertTo: "miles", Precision: services.DefaultDecimals}, TabForm("length")).Render(ctx, templ_7745c5c3_Buffer)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
@@ -168,7 +214,7 @@ func head(title string) templ.Component {
 		var templ_7745c5c3_Var5 string
 		templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(title)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 59, Col: 16}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 100, Col: 16}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
 		if templ_7745c5c3_Err != nil {
@@ -222,7 +268,23 @@ type Tabs []Tab
 var tabs = Tabs{
 	{Text: "Length", UnitType: "length", Active: true},
 	{Text: "Weight", UnitType: "weight", Active: false},
+	{Text: "Area", UnitType: "area", Active: false},
 	{Text: "Temperature", UnitType: "temperature", Active: false},
+	{Text: "Temperature change", UnitType: "temperature-difference", Active: false},
+	{Text: "Volume", UnitType: "volume", Active: false},
+	{Text: "Speed", UnitType: "speed", Active: false},
+	{Text: "Time", UnitType: "time", Active: false},
+	{Text: "Data", UnitType: "data", Active: false},
+	{Text: "Data rate", UnitType: "data-rate", Active: false},
+	{Text: "Pressure", UnitType: "pressure", Active: false},
+	{Text: "Energy", UnitType: "energy", Active: false},
+	{Text: "Power", UnitType: "power", Active: false},
+	{Text: "Angle", UnitType: "angle", Active: false},
+	{Text: "Fuel economy", UnitType: "fuel-economy", Active: false},
+	{Text: "Shoe size", UnitType: "shoe-size", Active: false},
+	{Text: "Ring size", UnitType: "ring-size", Active: false},
+	{Text: "Cooking", UnitType: CookingTab, Active: false},
+	{Text: "Typography", UnitType: TypographyTab, Active: false},
 }
 
 type Store struct {
@@ -230,6 +292,32 @@ type Store struct {
 	UnitToConvertFrom string  `json:"unitToConvertFrom"`
 	UnitToConvertTo   string  `json:"unitToConvertTo"`
 	ValueToConvert    float64 `json:"valueToConvert"`
+	Precision         int     `json:"precision"`
+	Substance         string  `json:"substance,omitempty"`
+	DPI               float64 `json:"dpi,omitempty"`
+	FontSize          float64 `json:"fontSize,omitempty"`
+	RootFontSize      float64 `json:"rootFontSize,omitempty"`
+	Density           float64 `json:"density,omitempty"`
+	FontScale         float64 `json:"fontScale,omitempty"`
+}
+
+// ParameterOptions passes the parameters filled in the form to the conversion
+func (s Store) ParameterOptions() []services.ConvertOption {
+	values := map[services.Parameter]float64{
+		services.DPI:          s.DPI,
+		services.FontSize:     s.FontSize,
+		services.RootFontSize: s.RootFontSize,
+		services.Density:      s.Density,
+		services.FontScale:    s.FontScale,
+	}
+
+	var opts []services.ConvertOption
+	for _, input := range TypographyParameters {
+		if value := values[input.Parameter]; value != 0 {
+			opts = append(opts, services.WithParameter(input.Parameter, value))
+		}
+	}
+	return opts
 }
 
 func TabNav(store *Store, tabContent templ.Component) templ.Component {
@@ -260,7 +348,7 @@ func TabNav(store *Store, tabContent templ.Component) templ.Component {
 		var templ_7745c5c3_Var8 string
 		templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(templ.JSONString(store))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 93, Col: 52}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 174, Col: 52}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
 		if templ_7745c5c3_Err != nil {
@@ -296,7 +384,7 @@ func TabNav(store *Store, tabContent templ.Component) templ.Component {
 			var templ_7745c5c3_Var11 string
 			templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.JoinStringErrs(fmt.Sprintf("$unitType='%s';$$get('/tabs/update')", tab.UnitType))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 96, Col: 181}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 177, Col: 181}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var11))
 			if templ_7745c5c3_Err != nil {
@@ -309,7 +397,7 @@ func TabNav(store *Store, tabContent templ.Component) templ.Component {
 			var templ_7745c5c3_Var12 string
 			templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.JoinStringErrs(tab.Text)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 96, Col: 194}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 177, Col: 194}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var12))
 			if templ_7745c5c3_Err != nil {
@@ -367,7 +455,7 @@ func TabForm(unitType string) templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		for _, elementBeingCompared := range FirstSelection[services.UnitType(strings.ToLower(unitType))] {
+		for _, elementBeingCompared := range selection(unitType) {
 			templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 18)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
@@ -375,7 +463,7 @@ func TabForm(unitType string) templ.Component {
 			var templ_7745c5c3_Var14 string
 			templ_7745c5c3_Var14, templ_7745c5c3_Err = templ.JoinStringErrs(elementBeingCompared)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 125, Col: 41}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 206, Col: 41}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var14))
 			if templ_7745c5c3_Err != nil {
@@ -388,7 +476,7 @@ func TabForm(unitType string) templ.Component {
 			var templ_7745c5c3_Var15 string
 			templ_7745c5c3_Var15, templ_7745c5c3_Err = templ.JoinStringErrs(elementBeingCompared)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 125, Col: 66}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 206, Col: 66}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var15))
 			if templ_7745c5c3_Err != nil {
@@ -403,7 +491,7 @@ func TabForm(unitType string) templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		for _, elementBeingCompared := range FirstSelection[services.UnitType(strings.ToLower(unitType))] {
+		for _, elementBeingCompared := range selection(unitType) {
 			templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 22)
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
@@ -411,7 +499,7 @@ func TabForm(unitType string) templ.Component {
 			var templ_7745c5c3_Var16 string
 			templ_7745c5c3_Var16, templ_7745c5c3_Err = templ.JoinStringErrs(elementBeingCompared)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 135, Col: 41}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 216, Col: 41}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var16))
 			if templ_7745c5c3_Err != nil {
@@ -424,7 +512,7 @@ func TabForm(unitType string) templ.Component {
 			var templ_7745c5c3_Var17 string
 			templ_7745c5c3_Var17, templ_7745c5c3_Err = templ.JoinStringErrs(elementBeingCompared)
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 135, Col: 66}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 216, Col: 66}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var17))
 			if templ_7745c5c3_Err != nil {
@@ -439,6 +527,149 @@ func TabForm(unitType string) templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
+		if unitType == CookingTab {
+			templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 26)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			for _, substance := range services.SubstanceNames() {
+				templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 27)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var18 string
+				templ_7745c5c3_Var18, templ_7745c5c3_Err = templ.JoinStringErrs(substance)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 227, Col: 31}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var18))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 28)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var19 string
+				templ_7745c5c3_Var19, templ_7745c5c3_Err = templ.JoinStringErrs(substance)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 227, Col: 45}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var19))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 29)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 30)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		if unitType == TypographyTab {
+			templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 31)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			for _, input := range TypographyParameters {
+				templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 32)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var20 string
+				templ_7745c5c3_Var20, templ_7745c5c3_Err = templ.JoinStringErrs(input.Model)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 235, Col: 80}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var20))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 33)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var21 string
+				templ_7745c5c3_Var21, templ_7745c5c3_Err = templ.JoinStringErrs(input.Label)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 236, Col: 19}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var21))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 34)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				var templ_7745c5c3_Var22 string
+				templ_7745c5c3_Var22, templ_7745c5c3_Err = templ.JoinStringErrs(input.Model)
+				if templ_7745c5c3_Err != nil {
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 238, Col: 152}
+				}
+				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var22))
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 35)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+			}
+			templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 36)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 37)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return templ_7745c5c3_Err
+	})
+}
+
+func FormError(message string) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var23 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var23 == nil {
+			templ_7745c5c3_Var23 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 38)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var24 string
+		templ_7745c5c3_Var24, templ_7745c5c3_Err = templ.JoinStringErrs(message)
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `components/home.templ`, Line: 256, Col: 63}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var24))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templ.WriteWatchModeString(templ_7745c5c3_Buffer, 39)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
 		return templ_7745c5c3_Err
 	})
 }
@@ -1,11 +1,14 @@
 package main
 
 import (
-	"fmt"
+	"errors"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
 
 	"github.com/delaneyj/datastar"
 	"github.com/go-chi/chi"
@@ -17,6 +20,11 @@ func main() {
 	fileServer := http.FileServer(http.Dir("./static"))
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
+	if path := os.Getenv("RATES_FILE"); path != "" {
+		loadRates(logger, path)
+		go reloadRatesOnHangup(logger, path)
+	}
+
 	router := chi.NewRouter()
 
 	router.Get("/", homeHandler)
@@ -29,6 +37,26 @@ func main() {
 
 }
 
+// loadRates reads the exchange rate file, keeping the rates in use when it cannot
+func loadRates(logger *slog.Logger, path string) {
+	if err := services.LoadRates(path); err != nil {
+		logger.Error("failed to load exchange rates", "path", path, "error", err)
+		return
+	}
+
+	logger.Info("Loaded exchange rates", "path", path)
+}
+
+// reloadRatesOnHangup reloads the exchange rate file on every SIGHUP
+func reloadRatesOnHangup(logger *slog.Logger, path string) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+
+	for range hangups {
+		loadRates(logger, path)
+	}
+}
+
 func homeHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/html")
 
@@ -45,6 +73,8 @@ func getTab(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The units of the previous tab are not offered by the new one
+	defaultOption(&tabStore)
 	tabForm := components.TabForm(tabStore.UnitType)
 
 	sse := datastar.NewSSE(w, r)
@@ -57,12 +87,61 @@ func defaultOption(store *components.Store) {
 	case "temperature":
 		store.UnitToConvertFrom = "celsius"
 		store.UnitToConvertTo = "fahrenheit"
+	case "temperature-difference":
+		store.UnitToConvertFrom = "delta-celsius"
+		store.UnitToConvertTo = "delta-fahrenheit"
 	case "length":
 		store.UnitToConvertFrom = "meters"
 		store.UnitToConvertTo = "feet"
 	case "weight":
 		store.UnitToConvertFrom = "grams"
 		store.UnitToConvertTo = "ounces"
+	case "area":
+		store.UnitToConvertFrom = "square-meters"
+		store.UnitToConvertTo = "square-feet"
+	case "volume":
+		store.UnitToConvertFrom = "liters"
+		store.UnitToConvertTo = "us-gallons"
+	case "speed":
+		store.UnitToConvertFrom = "kilometers-per-hour"
+		store.UnitToConvertTo = "miles-per-hour"
+	case "time":
+		store.UnitToConvertFrom = "hours"
+		store.UnitToConvertTo = "minutes"
+	case "data":
+		store.UnitToConvertFrom = "gigabytes"
+		store.UnitToConvertTo = "gibibytes"
+	case "data-rate":
+		store.UnitToConvertFrom = "megabits-per-second"
+		store.UnitToConvertTo = "megabytes-per-second"
+	case "pressure":
+		store.UnitToConvertFrom = "bars"
+		store.UnitToConvertTo = "pounds-per-square-inch"
+	case "energy":
+		store.UnitToConvertFrom = "kilocalories"
+		store.UnitToConvertTo = "kilojoules"
+	case "power":
+		store.UnitToConvertFrom = "kilowatts"
+		store.UnitToConvertTo = "horsepower"
+	case "angle":
+		store.UnitToConvertFrom = "degrees"
+		store.UnitToConvertTo = "radians"
+	case "fuel-economy":
+		store.UnitToConvertFrom = "liters-per-100-kilometers"
+		store.UnitToConvertTo = "miles-per-us-gallon"
+	case "shoe-size":
+		store.UnitToConvertFrom = "us-shoe-size"
+		store.UnitToConvertTo = "eu-shoe-size"
+	case "ring-size":
+		store.UnitToConvertFrom = "us-ring-size"
+		store.UnitToConvertTo = "iso-ring-size"
+	case components.CookingTab:
+		store.UnitToConvertFrom = "us-cups"
+		store.UnitToConvertTo = "grams"
+		store.Substance = "flour"
+	case components.TypographyTab:
+		store.UnitToConvertFrom = "pixels"
+		store.UnitToConvertTo = "points"
 	}
 }
 
@@ -73,25 +152,73 @@ func resultHandler(w http.ResponseWriter, r *http.Request) {
 	err := datastar.BodyUnmarshal(r, &tabStore)
 	log.Printf("tabStore: %+v", tabStore)
 
+	if err != nil {
+		http.Error(w, "failed to unmarshal", http.StatusBadRequest)
+		return
+	}
+
 	value := tabStore.ValueToConvert
 	unitToConvertFrom := tabStore.UnitToConvertFrom
 	unitToConvertTo := tabStore.UnitToConvertTo
 	unitType := tabStore.UnitType
 
-	log.Printf("\n------------\nunit type %s value %f from %s to %s\n------------", unitType, value, unitToConvertFrom, unitToConvertTo)
+	log.Printf("\n------------\nunit type %s value %f from %s to %s precision %d\n------------", unitType, value, unitToConvertFrom, unitToConvertTo, tabStore.Precision)
 
 	if unitToConvertFrom == "" || unitToConvertTo == "" {
 		components.Home().Render(r.Context(), w)
 	}
 
-	result, err := services.Convert(services.UnitType(unitType), services.Unit(unitToConvertFrom), services.Unit(unitToConvertTo), value)
+	opts := []services.ConvertOption{services.WithDecimals(tabStore.Precision)}
+	// The cooking tab mixes volumes and weights, converted through the ingredient's density
+	if unitType == components.CookingTab {
+		found, err := services.Lookup(unitToConvertFrom)
+		if err != nil {
+			http.Error(w, err.Error(), conversionErrorStatus(err))
+			return
+		}
+		unitType = string(found.Type)
+		opts = append(opts, services.WithSubstance(tabStore.Substance))
+	}
+	// The typography tab offers lengths, some of which depend on the parameters of the form
+	if unitType == components.TypographyTab {
+		unitType = string(services.Length)
+		opts = append(opts, tabStore.ParameterOptions()...)
+	}
+
+	result, err := services.Convert(services.UnitType(unitType), services.Unit(unitToConvertFrom), services.Unit(unitToConvertTo), value, opts...)
+
+	if isFormError(err) {
+		sse := datastar.NewSSE(w, r)
+		datastar.RenderFragmentTempl(sse, components.FormError(err.Error()), datastar.WithQuerySelectorID("form-error"))
+		return
+	}
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), conversionErrorStatus(err))
 		return
 	}
 
 	sse := datastar.NewSSE(w, r)
-	fragmentComponent := components.Result(fmt.Sprintf("%.2f", value), unitToConvertFrom, unitToConvertTo, fmt.Sprintf("%.2f", result))
+	fragmentComponent := components.Result(strconv.FormatFloat(value, 'f', -1, 64), unitToConvertFrom, unitToConvertTo, strconv.FormatFloat(result, 'f', tabStore.Precision, 64))
 	datastar.RenderFragmentTempl(sse, fragmentComponent, datastar.WithQuerySelectorID("tab-form"))
 }
+
+// isFormError reports whether err is about the value or parameters typed in the
+// form, which is shown next to the form rather than sent back as an HTTP error
+func isFormError(err error) bool {
+	return errors.Is(err, services.ErrOutOfDomain) || errors.Is(err, services.ErrMissingParameter) ||
+		errors.Is(err, services.ErrOutsideTable) || errors.Is(err, services.ErrBetweenEntries)
+}
+
+// conversionErrorStatus maps a conversion failure that is not a form error to
+// the HTTP status sent back to the client
+func conversionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrUnknownUnitType), errors.Is(err, services.ErrUnknownUnit), errors.Is(err, services.ErrAmbiguousUnit), errors.Is(err, services.ErrUnknownSubstance):
+		return http.StatusBadRequest
+	case errors.Is(err, services.ErrIncompatibleUnits), errors.Is(err, services.ErrNoRate):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
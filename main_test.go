@@ -1 +1,82 @@
 package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/components"
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversionErrorStatus(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "✅ unknown unit type", err: services.ErrUnknownUnitType, expected: http.StatusBadRequest},
+		{name: "✅ unknown unit", err: services.ErrUnknownUnit, expected: http.StatusBadRequest},
+		{name: "✅ ambiguous unit", err: services.ErrAmbiguousUnit, expected: http.StatusBadRequest},
+		{name: "✅ unknown substance", err: services.ErrUnknownSubstance, expected: http.StatusBadRequest},
+		{name: "✅ incompatible units", err: services.ErrIncompatibleUnits, expected: http.StatusUnprocessableEntity},
+		{name: "✅ no rate", err: services.ErrNoRate, expected: http.StatusUnprocessableEntity},
+		{name: "✅ wrapped error", err: fmt.Errorf("%w: %q", services.ErrUnknownUnit, "furlongs"), expected: http.StatusBadRequest},
+		{name: "❌ unexpected error", err: errors.New("boom"), expected: http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			asserts.Equal(test.expected, conversionErrorStatus(test.err), test.name)
+		})
+	}
+}
+
+func TestIsFormError(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "✅ out of domain", err: services.ErrOutOfDomain, expected: true},
+		{name: "✅ missing parameter", err: services.ErrMissingParameter, expected: true},
+		{name: "✅ outside table", err: services.ErrOutsideTable, expected: true},
+		{name: "✅ between entries", err: services.ErrBetweenEntries, expected: true},
+		{name: "❌ unknown unit", err: services.ErrUnknownUnit, expected: false},
+		{name: "❌ incompatible units", err: services.ErrIncompatibleUnits, expected: false},
+		{name: "❌ no error", err: nil, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			asserts.Equal(test.expected, isFormError(test.err), test.name)
+		})
+	}
+}
+
+func TestDefaultOptionIsOffered(t *testing.T) {
+	asserts := assert.New(t)
+
+	offered := map[string][]string{
+		components.CookingTab:    components.CookingUnits,
+		components.TypographyTab: components.TypographyUnits,
+	}
+	for unitType, units := range components.FirstSelection {
+		offered[string(unitType)] = units
+	}
+
+	for unitType, units := range offered {
+		t.Run(unitType, func(t *testing.T) {
+			store := components.Store{UnitType: unitType, UnitToConvertFrom: "meters", UnitToConvertTo: "miles"}
+			defaultOption(&store)
+			asserts.Contains(units, store.UnitToConvertFrom, unitType)
+			asserts.Contains(units, store.UnitToConvertTo, unitType)
+		})
+	}
+}
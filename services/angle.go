@@ -0,0 +1,203 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// AngleRange is the interval converted angles are normalized to
+type AngleRange int
+
+// Supported angle ranges
+const (
+	AnyAngle      AngleRange = iota // angles are left as they are
+	PositiveAngle                   // [0, 360°), e.g. compass bearings
+	SignedAngle                     // (-180°, 180°], e.g. longitudes and headings
+)
+
+// WithAngleRange normalizes the converted angle to the given range, expressed
+// in the unit converted to: 370° becomes 10°, 3π/2 rad becomes -π/2 rad
+func WithAngleRange(angleRange AngleRange) ConvertOption {
+	return func(o *ConvertOptions) {
+		o.AngleRange = angleRange
+	}
+}
+
+// normalize brings value, expressed in the unit to, within the angle range of the options
+func (o ConvertOptions) normalize(unitType UnitType, to UnitDefinition, value float64) (float64, error) {
+	if o.AngleRange == AnyAngle {
+		return value, nil
+	}
+
+	if unitType != Angle {
+		return 0, fmt.Errorf("%w: angle ranges only apply to angles, not %s", ErrIncompatibleUnits, unitType)
+	}
+
+	turn := to.FromBase(360)
+	// Angles within the range are left untouched, so normalizing twice changes nothing
+	if o.AngleRange == PositiveAngle && value >= 0 && value < turn || o.AngleRange == SignedAngle && value > -turn/2 && value <= turn/2 {
+		return value, nil
+	}
+
+	value = math.Mod(value, turn)
+	if value < 0 {
+		value += turn
+	}
+	// A tiny negative angle plus a turn rounds to a whole turn
+	if value >= turn {
+		value -= turn
+	}
+	if o.AngleRange == SignedAngle && value > turn/2 {
+		value -= turn
+	}
+
+	return value, nil
+}
+
+// normalizeResult is normalize for the results of ConvertRat, kept exact when
+// the unit converted to is
+func (o ConvertOptions) normalizeResult(unitType UnitType, to UnitDefinition, result ExactResult) (ExactResult, error) {
+	if o.AngleRange == AnyAngle {
+		return result, nil
+	}
+
+	if !result.Exact || to.exact == nil {
+		float, _ := result.Value.Float64()
+		float, err := o.normalize(unitType, to, float)
+		if err != nil {
+			return ExactResult{}, err
+		}
+		turn := new(big.Rat).SetFloat64(to.FromBase(360))
+		return ExactResult{Value: new(big.Rat).SetFloat64(float), Exact: false, turn: turn, angleRange: o.AngleRange}, nil
+	}
+
+	if unitType != Angle {
+		return ExactResult{}, fmt.Errorf("%w: angle ranges only apply to angles, not %s", ErrIncompatibleUnits, unitType)
+	}
+
+	turn := new(big.Rat).Quo(Rational(degreesPerTurn).exact, to.exact)
+	return ExactResult{Value: normalizeRat(result.Value, turn, o.AngleRange), Exact: true, turn: turn, angleRange: o.AngleRange}, nil
+}
+
+// normalizeRat brings value within angleRange, for a turn of the given size
+func normalizeRat(value, turn *big.Rat, angleRange AngleRange) *big.Rat {
+	// value - floor(value/turn)*turn
+	turns := new(big.Rat).Quo(value, turn)
+	floor := new(big.Int).Div(turns.Num(), turns.Denom())
+	normalized := new(big.Rat).Sub(value, new(big.Rat).Mul(new(big.Rat).SetInt(floor), turn))
+
+	if angleRange == SignedAngle && normalized.Cmp(new(big.Rat).Quo(turn, big.NewRat(2, 1))) > 0 {
+		normalized.Sub(normalized, turn)
+	}
+
+	return normalized
+}
+
+var dmsPattern = regexp.MustCompile(`^([+-])?(\d+(?:\.\d+)?)\s*[°d]\s*(?:(\d+(?:\.\d+)?)\s*['′m]\s*)?(?:(\d+(?:\.\d+)?)\s*(?:"|″|''|s)\s*)?([NSEWnsew])?$`)
+
+// ParseDMS reads an angle written in degrees, minutes and seconds, such as
+// 40°26'46"N, 73° 59′ 8.5″ W or -33d52m. Southern and western hemispheres are
+// negative. The angle is returned in degrees.
+func ParseDMS(s string) (Quantity, error) {
+	s = strings.TrimSpace(s)
+
+	match := dmsPattern.FindStringSubmatch(s)
+	if match == nil {
+		return Quantity{}, fmt.Errorf("%w: %q is not a degrees, minutes and seconds angle", ErrInvalidQuantity, s)
+	}
+
+	var parts [3]float64
+	fractional := false
+	for i, part := range match[2:5] {
+		if part == "" {
+			continue
+		}
+
+		// Only the last component given may have a fraction: 40.5°30' is ambiguous
+		if fractional {
+			return Quantity{}, fmt.Errorf("%w: %q has a fraction before its last component", ErrInvalidQuantity, s)
+		}
+		fractional = strings.Contains(part, ".")
+
+		value, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return Quantity{}, fmt.Errorf("%w: %v", ErrInvalidQuantity, err)
+		}
+		if i > 0 && value >= 60 {
+			return Quantity{}, fmt.Errorf("%w: %q has more than 59 minutes or seconds", ErrInvalidQuantity, s)
+		}
+		parts[i] = value
+	}
+
+	degrees := parts[0] + parts[1]/60 + parts[2]/3600
+
+	hemisphere := strings.ToUpper(match[5])
+	if match[1] == "-" && hemisphere != "" {
+		return Quantity{}, fmt.Errorf("%w: %q has both a sign and a hemisphere", ErrInvalidQuantity, s)
+	}
+	if match[1] == "-" || hemisphere == "S" || hemisphere == "W" {
+		degrees = -degrees
+	}
+
+	return Quantity{Value: degrees, Unit: Degrees, Type: Angle}, nil
+}
+
+// FormatDMS writes value, expressed in a unit of Angle, in degrees, minutes
+// and seconds such as -73°59'8", keeping the given number of decimals on the seconds
+func FormatDMS(value float64, unit Unit, decimals int) (string, error) {
+	degrees, err := Convert(Angle, unit, Degrees, value, WithoutRounding())
+	if err != nil {
+		return "", err
+	}
+
+	dms := formatDMS(math.Abs(degrees), decimals)
+	if degrees < 0 && dms != formatDMS(0, decimals) {
+		return "-" + dms, nil
+	}
+	return dms, nil
+}
+
+// FormatLatitude writes a latitude such as 40°26'46"N
+func FormatLatitude(value float64, unit Unit, decimals int) (string, error) {
+	return formatCoordinate(value, unit, decimals, 90, "N", "S")
+}
+
+// FormatLongitude writes a longitude such as 73°59'8"W
+func FormatLongitude(value float64, unit Unit, decimals int) (string, error) {
+	return formatCoordinate(value, unit, decimals, 180, "E", "W")
+}
+
+func formatCoordinate(value float64, unit Unit, decimals int, limit float64, positive, negative string) (string, error) {
+	degrees, err := Convert(Angle, unit, Degrees, value, WithoutRounding())
+	if err != nil {
+		return "", err
+	}
+
+	if math.Abs(degrees) > limit {
+		return "", fmt.Errorf("%w: %s %s is beyond %s°", ErrOutOfDomain, formatValue(value), unit, formatValue(limit))
+	}
+
+	hemisphere := positive
+	if degrees < 0 {
+		hemisphere = negative
+	}
+
+	return formatDMS(math.Abs(degrees), decimals) + hemisphere, nil
+}
+
+// formatDMS splits a non-negative angle in degrees, carrying seconds that
+// round up to 60 into the minutes and minutes into the degrees
+func formatDMS(degrees float64, decimals int) string {
+	scale := math.Pow(10, float64(decimals))
+	total := math.Round(degrees*3600*scale) / scale
+
+	whole := math.Floor(total / 3600)
+	minutes := math.Floor((total - whole*3600) / 60)
+	seconds := total - whole*3600 - minutes*60
+
+	return fmt.Sprintf(`%.0f°%.0f'%s"`, whole, minutes, strconv.FormatFloat(seconds, 'f', decimals, 64))
+}
@@ -0,0 +1,430 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoRate is returned when no exchange rate is known for a currency on a date
+var ErrNoRate = errors.New("no exchange rate")
+
+// RateDateLayout is the layout of the dates in rate files
+const RateDateLayout = time.DateOnly
+
+// Rate is the amount of Currency worth one unit of the base currency of a
+// RateTable, effective from Date until the next rate of the same currency
+type Rate struct {
+	Currency Unit
+	Date     time.Time
+	Value    *big.Rat
+}
+
+// RateTable holds the history of exchange rates against a base currency
+type RateTable struct {
+	Base Unit
+
+	// history holds the rates of every currency, oldest first
+	history map[Unit][]Rate
+	// latest holds the definitions of every currency at its latest rate
+	latest map[Unit]UnitDefinition
+}
+
+// NewRateTable builds a table of rates against base, a three-letter ISO 4217
+// code such as "EUR". Currency codes are case-insensitive.
+func NewRateTable(base string, rates []Rate) (*RateTable, error) {
+	baseUnit, err := currencyUnit(base)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &RateTable{Base: baseUnit, history: map[Unit][]Rate{}, latest: map[Unit]UnitDefinition{}}
+	for _, rate := range rates {
+		currency, err := currencyUnit(string(rate.Currency))
+		if err != nil {
+			return nil, err
+		}
+		if currency == baseUnit {
+			continue
+		}
+		if rate.Value == nil || rate.Value.Sign() <= 0 {
+			return nil, fmt.Errorf("%w: the %s rate of %s is not positive", ErrInvalidQuantity, currency, rate.Date.Format(RateDateLayout))
+		}
+
+		rate.Currency = currency
+		table.history[currency] = append(table.history[currency], rate)
+	}
+
+	table.latest[baseUnit] = currencyDefinition(baseUnit, big.NewRat(1, 1))
+	for currency, history := range table.history {
+		sort.SliceStable(history, func(i, j int) bool { return history[i].Date.Before(history[j].Date) })
+		table.latest[currency] = currencyDefinition(currency, history[len(history)-1].Value)
+	}
+
+	return table, nil
+}
+
+// RateOn returns the rate of currency effective on date, the latest rate when
+// date is zero. The base currency has a rate of 1 and no date.
+func (t *RateTable) RateOn(currency Unit, date time.Time) (Rate, error) {
+	if t == nil {
+		return Rate{}, fmt.Errorf("%w: no exchange rates are loaded", ErrNoRate)
+	}
+
+	if currency == t.Base {
+		return Rate{Currency: currency, Value: big.NewRat(1, 1)}, nil
+	}
+
+	history := t.history[currency]
+	if date.IsZero() && len(history) > 0 {
+		return history[len(history)-1], nil
+	}
+
+	// The first rate after date is one past the rate in effect
+	i := sort.Search(len(history), func(i int) bool { return history[i].Date.After(date) })
+	if i == 0 {
+		return Rate{}, fmt.Errorf("%w for %s on %s", ErrNoRate, currency, date.Format(RateDateLayout))
+	}
+
+	return history[i-1], nil
+}
+
+// currencyDefinition defines a currency worth 1/rate units of the base currency
+func currencyDefinition(currency Unit, rate *big.Rat) UnitDefinition {
+	exact := new(big.Rat).Inv(rate)
+	float, _ := exact.Float64()
+	return UnitDefinition{Factor: float, exact: exact}.Named(strings.ToUpper(string(currency)))
+}
+
+// currencyUnit checks an ISO 4217 code and returns its unit, the lowercase code
+func currencyUnit(code string) (Unit, error) {
+	code = strings.TrimSpace(code)
+	if len(code) != 3 || strings.IndexFunc(code, func(r rune) bool { return (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') }) >= 0 {
+		return "", fmt.Errorf("%w: %q is not an ISO 4217 currency code", ErrInvalidQuantity, code)
+	}
+
+	return Unit(strings.ToLower(code)), nil
+}
+
+// currencyRates holds the rates in use. Rates are swapped as a whole by
+// UseRates, so readers only need the lock to take the current table.
+var currencyRates struct {
+	sync.RWMutex
+	table *RateTable
+}
+
+// UseRates makes table the exchange rates used to convert currencies.
+// It is safe to call while conversions are running; nil unloads the rates.
+func UseRates(table *RateTable) {
+	currencyRates.Lock()
+	defer currencyRates.Unlock()
+
+	currencyRates.table = table
+}
+
+// CurrentRates returns the exchange rates in use, nil when none are loaded
+func CurrentRates() *RateTable {
+	currencyRates.RLock()
+	defer currencyRates.RUnlock()
+
+	return currencyRates.table
+}
+
+// currency finds a currency of the table by its code, in any case
+func (t *RateTable) currency(unit Unit) (Unit, error) {
+	currency := Unit(strings.ToLower(strings.TrimSpace(string(unit))))
+	if t == nil {
+		return "", unknownUnitError(Currency, unit)
+	}
+	if _, ok := t.latest[currency]; !ok {
+		return "", unknownUnitError(Currency, unit)
+	}
+
+	return currency, nil
+}
+
+// lookupPair finds the definitions of fromUnit and toUnit at their latest
+// rates in the table, so that a conversion reads all of its rates from one table
+func (t *RateTable) lookupPair(fromUnit, toUnit Unit) (UnitDefinition, UnitDefinition, error) {
+	var definitions [2]UnitDefinition
+	for i, unit := range []Unit{fromUnit, toUnit} {
+		currency, err := t.currency(unit)
+		if err != nil {
+			return UnitDefinition{}, UnitDefinition{}, err
+		}
+		definitions[i] = t.latest[currency]
+	}
+
+	return definitions[0], definitions[1], nil
+}
+
+// currencyUnits returns the definitions of the currencies at their latest rate
+func currencyUnits() map[Unit]UnitDefinition {
+	table := CurrentRates()
+	if table == nil {
+		return map[Unit]UnitDefinition{}
+	}
+	return table.latest
+}
+
+// RateFormat is the format of a rate file
+type RateFormat string
+
+// Supported rate file formats
+const (
+	// RatesCSV has a header naming its date, currency and rate columns, and an
+	// optional base column that defaults to EUR
+	RatesCSV RateFormat = "csv"
+	// RatesJSON is {"base": "EUR", "date": "2024-05-02", "rates": {"USD": 1.0708}},
+	// with any older days under "history": [{"date": ..., "rates": {...}}]
+	RatesJSON RateFormat = "json"
+	// RatesXML is the euro foreign exchange reference rates file of the ECB
+	RatesXML RateFormat = "xml"
+)
+
+// defaultBaseCurrency is the base of rate files that do not name one
+const defaultBaseCurrency = "EUR"
+
+// LoadRates reads the rate file at path, whose format follows its extension,
+// and uses its rates from then on. The rates in use are kept when it fails.
+func LoadRates(path string) error {
+	format := RateFormat(strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")))
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	table, err := ReadRates(file, format)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	UseRates(table)
+	return nil
+}
+
+// ReadRates reads a rate file in the given format
+func ReadRates(r io.Reader, format RateFormat) (*RateTable, error) {
+	switch format {
+	case RatesCSV:
+		return readCSVRates(r)
+	case RatesJSON:
+		return readJSONRates(r)
+	case RatesXML:
+		return readXMLRates(r)
+	default:
+		return nil, fmt.Errorf("unsupported rate file format %q", format)
+	}
+}
+
+func readCSVRates(r io.Reader) (*RateTable, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: empty rate file", ErrInvalidQuantity)
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"date", "currency", "rate"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: the rate file has no %s column", ErrInvalidQuantity, name)
+		}
+	}
+
+	base := defaultBaseCurrency
+	var rates []Rate
+	for i, record := range records[1:] {
+		if column, ok := columns["base"]; ok {
+			if i > 0 && !strings.EqualFold(record[column], base) {
+				return nil, fmt.Errorf("%w: the rate file mixes base currencies %s and %s", ErrInvalidQuantity, base, record[column])
+			}
+			base = record[column]
+		}
+
+		rate, err := parseRate(record[columns["currency"]], record[columns["date"]], record[columns["rate"]])
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	return NewRateTable(base, rates)
+}
+
+type jsonRates struct {
+	Base    string                 `json:"base"`
+	Date    string                 `json:"date"`
+	Rates   map[string]json.Number `json:"rates"`
+	History []struct {
+		Date  string                 `json:"date"`
+		Rates map[string]json.Number `json:"rates"`
+	} `json:"history"`
+}
+
+func readJSONRates(r io.Reader) (*RateTable, error) {
+	var file jsonRates
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+
+	if file.Base == "" {
+		file.Base = defaultBaseCurrency
+	}
+
+	var rates []Rate
+	add := func(date string, values map[string]json.Number) error {
+		for currency, value := range values {
+			rate, err := parseRate(currency, date, value.String())
+			if err != nil {
+				return err
+			}
+			rates = append(rates, rate)
+		}
+		return nil
+	}
+
+	if err := add(file.Date, file.Rates); err != nil {
+		return nil, err
+	}
+	for _, day := range file.History {
+		if err := add(day.Date, day.Rates); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewRateTable(file.Base, rates)
+}
+
+// xmlRates follows the gesmes envelope of the ECB, where a Cube per day holds
+// a Cube per currency
+type xmlRates struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+func readXMLRates(r io.Reader) (*RateTable, error) {
+	var file xmlRates
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+
+	var rates []Rate
+	for _, day := range file.Days {
+		for _, value := range day.Rates {
+			rate, err := parseRate(value.Currency, day.Time, value.Rate)
+			if err != nil {
+				return nil, err
+			}
+			rates = append(rates, rate)
+		}
+	}
+
+	return NewRateTable(defaultBaseCurrency, rates)
+}
+
+// parseRate reads a rate written as a decimal, keeping it exact
+func parseRate(currency, date, value string) (Rate, error) {
+	day, err := time.Parse(RateDateLayout, strings.TrimSpace(date))
+	if err != nil {
+		return Rate{}, fmt.Errorf("%w: invalid rate date %q", ErrInvalidQuantity, date)
+	}
+
+	rate, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: invalid %s rate %q", ErrInvalidQuantity, currency, value)
+	}
+
+	return Rate{Currency: Unit(currency), Date: day, Value: rate}, nil
+}
+
+// WithRateDate converts currencies at the rates effective on date instead of
+// the latest ones, provided the rate file holds their history
+func WithRateDate(date time.Time) ConvertOption {
+	return func(o *ConvertOptions) {
+		o.RateDate = date
+	}
+}
+
+// rates replaces the definitions of the currencies of a conversion by their
+// definitions at the rates effective on the date of the options, if any
+func (o ConvertOptions) rates(unitType UnitType, from, to UnitDefinition) (UnitDefinition, UnitDefinition, error) {
+	if o.RateDate.IsZero() {
+		return from, to, nil
+	}
+
+	if unitType != Currency {
+		return from, to, fmt.Errorf("%w: rate dates only apply to currencies, not %s", ErrIncompatibleUnits, unitType)
+	}
+
+	var definitions [2]UnitDefinition
+	for i, definition := range []UnitDefinition{from, to} {
+		currency := Unit(strings.ToLower(definition.Symbol))
+		rate, err := o.rateTable.RateOn(currency, o.RateDate)
+		if err != nil {
+			return from, to, err
+		}
+		definitions[i] = currencyDefinition(currency, rate.Value)
+	}
+
+	return definitions[0], definitions[1], nil
+}
+
+// CurrencyResult is a converted amount of money and the date of the rates used
+type CurrencyResult struct {
+	Value float64
+	// Date is the effective date of the rates used. When the two currencies were
+	// last quoted on different days it is the earlier one; it is zero when both
+	// are the base currency.
+	Date time.Time
+}
+
+// ConvertCurrency is Convert for currencies, also reporting the date of the rates used
+func ConvertCurrency(fromUnit, toUnit Unit, value float64, opts ...ConvertOption) (CurrencyResult, error) {
+	// The date is read from the very rates the conversion used, even if they are reloaded meanwhile
+	options := newConvertOptions(opts...)
+	options.rateTable = CurrentRates()
+	converted, _, err := convert(Currency, fromUnit, toUnit, value, options)
+	if err != nil {
+		return CurrencyResult{}, err
+	}
+
+	result := CurrencyResult{Value: converted}
+	for _, unit := range []Unit{fromUnit, toUnit} {
+		currency, err := options.rateTable.currency(unit)
+		if err != nil {
+			return CurrencyResult{}, err
+		}
+
+		rate, err := options.rateTable.RateOn(currency, options.RateDate)
+		if err != nil {
+			return CurrencyResult{}, err
+		}
+		if !rate.Date.IsZero() && (result.Date.IsZero() || rate.Date.Before(result.Date)) {
+			result.Date = rate.Date
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,445 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// BaseDimension indexes the SI base dimensions, and information
+type BaseDimension int
+
+// Base dimensions
+const (
+	DimLength BaseDimension = iota
+	DimMass
+	DimTime
+	DimCurrent
+	DimTemperature
+	DimAmount
+	DimLuminosity
+	// DimInformation is not an SI dimension; it keeps data apart from dimensionless numbers
+	DimInformation
+	// DimCurrency keeps money apart from dimensionless numbers too
+	DimCurrency
+
+	baseDimensionCount
+)
+
+// Dimension holds the exponents of a quantity over the SI base dimensions,
+// e.g. velocity is Length¹·Time⁻¹
+type Dimension [baseDimensionCount]int
+
+// Dimensions holds the dimension of every unit type
+var Dimensions = map[UnitType]Dimension{
+	Temperature:           {DimTemperature: 1},
+	TemperatureDifference: {DimTemperature: 1},
+	Length:                {DimLength: 1},
+	Weight:                {DimMass: 1},
+	Time:                  {DimTime: 1},
+	Force:                 {DimMass: 1, DimLength: 1, DimTime: -2},
+	Energy:                {DimMass: 1, DimLength: 2, DimTime: -2},
+	Volume:                {DimLength: 3},
+	Area:                  {DimLength: 2},
+	Speed:                 {DimLength: 1, DimTime: -1},
+	Data:                  {DimInformation: 1},
+	DataRate:              {DimInformation: 1, DimTime: -1},
+	Pressure:              {DimMass: 1, DimLength: -1, DimTime: -2},
+	Power:                 {DimMass: 1, DimLength: 2, DimTime: -3},
+	Angle:                 {},              // radians are dimensionless in the SI
+	FuelEconomy:           {DimLength: -2}, // distance per volume
+	Currency:              {DimCurrency: 1},
+	ShoeSize:              {DimLength: 1}, // foot length
+	RingSize:              {DimLength: 1}, // inner circumference
+}
+
+// baseFactors holds the size of a unit type's base unit in coherent SI units,
+// for the unit types whose base unit is not the coherent one
+var baseFactors = map[UnitType]float64{
+	Weight:      0.001,         // grams to kilograms
+	Angle:       math.Pi / 180, // degrees to radians
+	FuelEconomy: 1e6,           // km/L to m/m³
+	ShoeSize:    0.001,         // millimeters to meters
+	RingSize:    0.001,         // millimeters to meters
+}
+
+var dimensionSymbols = [baseDimensionCount]string{"L", "M", "T", "I", "Θ", "N", "J", "D", "¤"}
+
+// Mul returns the dimension of a product of quantities of dimensions d and other
+func (d Dimension) Mul(other Dimension) Dimension {
+	for i := range d {
+		d[i] += other[i]
+	}
+	return d
+}
+
+// Pow returns the dimension of a quantity of dimension d raised to n
+func (d Dimension) Pow(n int) Dimension {
+	for i := range d {
+		d[i] *= n
+	}
+	return d
+}
+
+// String writes the dimension with the conventional symbols, e.g. "L·T⁻¹",
+// or "1" when it is dimensionless
+func (d Dimension) String() string {
+	var factors []string
+	for i, exponent := range d {
+		switch exponent {
+		case 0:
+		case 1:
+			factors = append(factors, dimensionSymbols[i])
+		default:
+			factors = append(factors, dimensionSymbols[i]+superscript(exponent))
+		}
+	}
+
+	if len(factors) == 0 {
+		return "1"
+	}
+	return strings.Join(factors, "·")
+}
+
+// CompoundUnit is a product of units raised to integer powers, such as km/h or kg·m/s²
+type CompoundUnit struct {
+	Expression string
+	Dimension  Dimension
+	// Factor is the size of the unit in coherent SI units
+	Factor float64
+
+	// single is set when the expression is a plain unit, which may be affine
+	single *UnitRef
+	// difference is set when the expression holds a temperature difference
+	// such as ΔK, which shares its dimension with temperatures
+	difference bool
+	// types lists the unit types of the factors, whose domains bound the value
+	types []UnitType
+}
+
+// ParseUnit reads a compound unit expression. Factors are separated by "·",
+// "*" or spaces and may carry an exponent ("s^2", "s²", "m^-1"); everything
+// after a "/" up to the next "/" is in the denominator, so "W/m·K" is W/(m·K).
+// Every factor is resolved with Lookup, unless the whole expression names a
+// unit such as L/100km. Affine units such as °C, inverse units such as
+// L/100km, units that depend on a Parameter such as px and table-backed units
+// such as gas marks are only allowed on their own.
+func ParseUnit(expression string) (CompoundUnit, error) {
+	compound := CompoundUnit{Expression: strings.TrimSpace(expression), Factor: 1}
+	if compound.Expression == "" {
+		return CompoundUnit{}, fmt.Errorf("%w: empty unit expression", ErrInvalidQuantity)
+	}
+
+	if ref, err := Lookup(compound.Expression); err == nil {
+		definition, _ := Definition(ref.Type, ref.Unit)
+		compound.Dimension = Dimensions[ref.Type]
+		compound.Factor = definition.Factor * baseFactor(ref.Type)
+		compound.single = &ref
+		compound.difference = ref.Type == TemperatureDifference
+		compound.types = []UnitType{ref.Type}
+		return compound, nil
+	}
+
+	var factors int
+	var affine, inverse, parameterized, tabulated Unit
+	for i, group := range strings.Split(compound.Expression, "/") {
+		sign := 1
+		if i > 0 {
+			sign = -1
+		}
+
+		for _, factor := range strings.FieldsFunc(group, isFactorSeparator) {
+			name, exponent, err := splitExponent(factor)
+			if err != nil {
+				return CompoundUnit{}, err
+			}
+
+			ref, err := Lookup(name)
+			if err != nil {
+				return CompoundUnit{}, err
+			}
+
+			definition, _ := Definition(ref.Type, ref.Unit)
+			exponent *= sign
+			if definition.Offset != 0 {
+				affine = ref.Unit
+			}
+			if definition.Inverse {
+				inverse = ref.Unit
+			}
+			if len(definition.Parameters) > 0 {
+				parameterized = ref.Unit
+			}
+			if definition.Table != nil {
+				tabulated = ref.Unit
+			}
+			if ref.Type == TemperatureDifference {
+				compound.difference = true
+			}
+			if factors == 0 && exponent == 1 {
+				compound.single = &ref
+			}
+			factors++
+			compound.types = append(compound.types, ref.Type)
+
+			compound.Dimension = compound.Dimension.Mul(Dimensions[ref.Type].Pow(exponent))
+			compound.Factor *= math.Pow(definition.Factor*baseFactor(ref.Type), float64(exponent))
+		}
+	}
+
+	if factors == 0 {
+		return CompoundUnit{}, fmt.Errorf("%w: %q has no units", ErrInvalidQuantity, expression)
+	}
+
+	if factors > 1 {
+		compound.single = nil
+	}
+
+	if affine != "" && compound.single == nil {
+		return CompoundUnit{}, fmt.Errorf("%w: affine unit %q cannot be combined in %q", ErrIncompatibleUnits, affine, expression)
+	}
+
+	if inverse != "" && compound.single == nil {
+		return CompoundUnit{}, fmt.Errorf("%w: inverse unit %q cannot be combined in %q", ErrIncompatibleUnits, inverse, expression)
+	}
+
+	if parameterized != "" && compound.single == nil {
+		return CompoundUnit{}, fmt.Errorf("%w: %q depends on context parameters and cannot be combined in %q", ErrIncompatibleUnits, parameterized, expression)
+	}
+
+	if tabulated != "" && compound.single == nil {
+		return CompoundUnit{}, fmt.Errorf("%w: %q is read from a table and cannot be combined in %q", ErrIncompatibleUnits, tabulated, expression)
+	}
+
+	return compound, nil
+}
+
+// ConvertUnits converts value between two unit expressions of the same
+// dimension, e.g. from "km/h" to "m/s" or from "kWh" to "N·m". Temperatures
+// and temperature differences share a dimension but do not convert to each other.
+// Negative values are rejected when a factor of from is of a unit type that
+// only accepts non-negative values, such as kg·m, unless WithSigned allows
+// them for that type.
+func ConvertUnits(value float64, from, to string, opts ...ConvertOption) (float64, error) {
+	fromUnit, err := ParseUnit(from)
+	if err != nil {
+		return 0, err
+	}
+
+	toUnit, err := ParseUnit(to)
+	if err != nil {
+		return 0, err
+	}
+
+	if fromUnit.Dimension != toUnit.Dimension {
+		return 0, &DimensionError{From: fromUnit, To: toUnit}
+	}
+
+	// A reading of 20 °C is not a change of 20 K, whatever their dimension
+	if fromUnit.reading() && toUnit.difference || toUnit.reading() && fromUnit.difference {
+		return 0, fmt.Errorf("%w: %q and %q are a temperature and a temperature difference; convert differences of temperatures with WithInterval",
+			ErrIncompatibleUnits, fromUnit.Expression, toUnit.Expression)
+	}
+
+	// Plain units of one type go through Convert, which also handles affine units
+	if fromUnit.single != nil && toUnit.single != nil && fromUnit.single.Type == toUnit.single.Type {
+		return Convert(fromUnit.single.Type, fromUnit.single.Unit, toUnit.single.Unit, value, opts...)
+	}
+
+	for _, unit := range []CompoundUnit{fromUnit, toUnit} {
+		if unit.parameterized() {
+			return 0, fmt.Errorf("%w: %q depends on context parameters and only converts to units of its type", ErrIncompatibleUnits, unit.Expression)
+		}
+		if unit.tabulated() {
+			return 0, fmt.Errorf("%w: %q is read from a table and only converts to units of its type", ErrIncompatibleUnits, unit.Expression)
+		}
+	}
+
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, &DomainError{Unit: Unit(fromUnit.Expression), Value: value}
+	}
+
+	options := newConvertOptions(opts...)
+	coherent, err := fromUnit.toCoherent(value, options)
+	if err != nil {
+		return 0, err
+	}
+
+	result := toUnit.fromCoherent(coherent)
+	if err := checkFinite("", Unit(fromUnit.Expression), Unit(toUnit.Expression), value, result); err != nil {
+		return 0, err
+	}
+
+	return options.Round(result), nil
+}
+
+// nonlinear returns the definition of c when it is a plain unit that is not
+// a multiple of the coherent unit: an affine unit such as °C or an inverse
+// unit such as L/100km
+func (c CompoundUnit) nonlinear() (UnitDefinition, bool) {
+	if c.single == nil {
+		return UnitDefinition{}, false
+	}
+
+	definition, _ := Definition(c.single.Type, c.single.Unit)
+	return definition, definition.Inverse || definition.Offset != 0
+}
+
+// reading reports a plain temperature unit, which reads an absolute temperature
+func (c CompoundUnit) reading() bool {
+	return c.single != nil && c.single.Type == Temperature
+}
+
+// parameterized reports a plain unit whose size depends on context parameters
+func (c CompoundUnit) parameterized() bool {
+	if c.single == nil {
+		return false
+	}
+
+	definition, _ := Definition(c.single.Type, c.single.Unit)
+	return len(definition.Parameters) > 0
+}
+
+// tabulated reports a plain unit read from a table
+func (c CompoundUnit) tabulated() bool {
+	if c.single == nil {
+		return false
+	}
+
+	definition, _ := Definition(c.single.Type, c.single.Unit)
+	return definition.Table != nil
+}
+
+// toCoherent expresses value, in c, in coherent SI units. Affine and inverse
+// units are not a plain factor and go through the base unit of their type.
+func (c CompoundUnit) toCoherent(value float64, options ConvertOptions) (float64, error) {
+	definition, ok := c.nonlinear()
+	if !ok {
+		if err := c.checkDomain(value, options.Signed); err != nil {
+			return 0, err
+		}
+		return value * c.Factor, nil
+	}
+
+	if err := checkDomain(c.single.Type, c.single.Unit, definition, value, options.Signed); err != nil {
+		return 0, err
+	}
+	return definition.ToBase(value) * baseFactor(c.single.Type), nil
+}
+
+// checkDomain reports a *DomainError when value is negative and a factor of
+// c is of a unit type that only accepts non-negative values, unless signed
+// lifts that bound. Whatever their exponents, such factors multiply to a
+// non-negative quantity.
+func (c CompoundUnit) checkDomain(value float64, signed bool) error {
+	if value >= 0 {
+		return nil
+	}
+
+	for _, unitType := range c.types {
+		domain, ok := Domains[unitType]
+		if ok && domain.Min == 0 && !(signed && domain.Signed) {
+			return &DomainError{UnitType: unitType, Unit: Unit(c.Expression), Value: value, Min: 0}
+		}
+	}
+
+	return nil
+}
+
+// fromCoherent is the inverse of toCoherent
+func (c CompoundUnit) fromCoherent(value float64) float64 {
+	definition, ok := c.nonlinear()
+	if !ok {
+		return value / c.Factor
+	}
+
+	return definition.FromBase(value / baseFactor(c.single.Type))
+}
+
+// DimensionError reports unit expressions of different dimensions.
+// It wraps ErrIncompatibleUnits.
+type DimensionError struct {
+	From CompoundUnit
+	To   CompoundUnit
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("%s: %q is %s, %q is %s", ErrIncompatibleUnits,
+		e.From.Expression, e.From.Dimension, e.To.Expression, e.To.Dimension)
+}
+
+func (e *DimensionError) Unwrap() error {
+	return ErrIncompatibleUnits
+}
+
+func baseFactor(unitType UnitType) float64 {
+	if factor, ok := baseFactors[unitType]; ok {
+		return factor
+	}
+	return 1
+}
+
+func isFactorSeparator(r rune) bool {
+	switch r {
+	case '·', '⋅', '*', '×', ' ':
+		return true
+	}
+	return false
+}
+
+const (
+	superscriptDigits = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+	superscriptMinus  = '⁻'
+	superscriptPlus   = '⁺'
+)
+
+// splitExponent separates "s^2", "s²" or "m⁻¹" into the unit and its exponent
+func splitExponent(factor string) (string, int, error) {
+	if name, exponent, ok := strings.Cut(factor, "^"); ok {
+		n, err := strconv.Atoi(exponent)
+		if err != nil || name == "" {
+			return "", 0, fmt.Errorf("%w: invalid exponent in %q", ErrInvalidQuantity, factor)
+		}
+		return name, n, nil
+	}
+
+	name := strings.TrimRightFunc(factor, func(r rune) bool {
+		return strings.ContainsRune(superscriptDigits, r) || r == superscriptMinus || r == superscriptPlus
+	})
+	if name == factor {
+		return factor, 1, nil
+	}
+
+	var digits strings.Builder
+	for _, r := range factor[len(name):] {
+		switch r {
+		case superscriptMinus:
+			digits.WriteRune('-')
+		case superscriptPlus:
+			digits.WriteRune('+')
+		default:
+			digits.WriteRune('0' + rune(slices.Index([]rune(superscriptDigits), r)))
+		}
+	}
+
+	n, err := strconv.Atoi(digits.String())
+	if err != nil || name == "" {
+		return "", 0, fmt.Errorf("%w: invalid exponent in %q", ErrInvalidQuantity, factor)
+	}
+
+	return name, n, nil
+}
+
+func superscript(n int) string {
+	var digits strings.Builder
+	for _, r := range strconv.Itoa(n) {
+		if r == '-' {
+			digits.WriteRune(superscriptMinus)
+			continue
+		}
+		digits.WriteString(string([]rune(superscriptDigits)[r-'0']))
+	}
+	return digits.String()
+}
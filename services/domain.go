@@ -0,0 +1,99 @@
+package services
+
+import (
+	"math"
+	"math/big"
+)
+
+// Domain bounds the values a unit type accepts, expressed in its base unit
+type Domain struct {
+	Min float64
+	// Signed marks quantities that may also be read as a signed displacement
+	// (e.g. -3 meters), so WithSigned lifts Min for them. Absolute quantities
+	// such as temperatures are never signed.
+	Signed bool
+}
+
+// Domains holds the valid domain of each unit type. Every unit type only
+// accepts finite values; types missing here accept any finite value.
+var Domains = map[UnitType]Domain{
+	Temperature: {Min: 0}, // absolute zero
+	Length:      {Min: 0, Signed: true},
+	Weight:      {Min: 0, Signed: true},
+	Time:        {Min: 0, Signed: true},
+	Volume:      {Min: 0, Signed: true},
+	Area:        {Min: 0, Signed: true},
+	Data:        {Min: 0, Signed: true},
+	DataRate:    {Min: 0, Signed: true},
+	Pressure:    {Min: 0}, // vacuum; gauge readings go down to minus one atmosphere
+	FuelEconomy: {Min: 0},
+	Currency:    {Min: 0, Signed: true}, // debts are negative amounts
+	ShoeSize:    {Min: 0},
+	RingSize:    {Min: 0},
+}
+
+// checkDomain reports a *DomainError when value, expressed in unit, is outside the domain of unitType
+func checkDomain(unitType UnitType, unit Unit, definition UnitDefinition, value float64, signed bool) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return &DomainError{UnitType: unitType, Unit: unit, Value: value}
+	}
+
+	domain, ok := Domains[unitType]
+	if !ok || (signed && domain.Signed) {
+		return nil
+	}
+
+	// Inverse units grow as the base unit shrinks, so only their sign can be checked
+	if definition.Inverse {
+		if value < 0 {
+			return &DomainError{UnitType: unitType, Unit: unit, Value: value, Min: 0}
+		}
+		return nil
+	}
+
+	if definition.ToBase(value) < domain.Min {
+		return &DomainError{UnitType: unitType, Unit: unit, Value: value, Min: definition.FromBase(domain.Min), Descending: definition.Factor < 0}
+	}
+
+	return nil
+}
+
+// checkDomainRat is checkDomain for a rational value, which is always finite.
+// Units without an exact factor, and tables, are read in float64, so their
+// values are checked as such.
+func checkDomainRat(unitType UnitType, unit Unit, definition UnitDefinition, value *big.Rat, signed bool) error {
+	float, _ := value.Float64()
+	if definition.exact == nil || definition.Table != nil {
+		return checkDomain(unitType, unit, definition, float, signed)
+	}
+
+	domain, ok := Domains[unitType]
+	if !ok || (signed && domain.Signed) {
+		return nil
+	}
+
+	if definition.Inverse {
+		if value.Sign() < 0 {
+			return &DomainError{UnitType: unitType, Unit: unit, Value: float, Min: 0}
+		}
+		return nil
+	}
+
+	base := new(big.Rat).Mul(value, definition.exact)
+	base.Add(base, new(big.Rat).SetFloat64(definition.Offset))
+	if base.Cmp(new(big.Rat).SetFloat64(domain.Min)) < 0 {
+		return &DomainError{UnitType: unitType, Unit: unit, Value: float, Min: definition.FromBase(domain.Min), Descending: definition.exact.Sign() < 0}
+	}
+
+	return nil
+}
+
+// checkFinite reports a *DomainError when a finite value converts to an
+// infinite result, as zero does between a unit and an inverse unit
+func checkFinite(unitType UnitType, fromUnit, toUnit Unit, value, result float64) error {
+	if math.IsInf(result, 0) && !math.IsInf(value, 0) {
+		return &DomainError{UnitType: unitType, Unit: fromUnit, Value: value, Target: toUnit}
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var isoDurationPattern = regexp.MustCompile(`^([+-])?P(?:([\d.,]+)Y)?(?:([\d.,]+)M)?(?:([\d.,]+)W)?(?:([\d.,]+)D)?(?:T(?:([\d.,]+)H)?(?:([\d.,]+)M)?(?:([\d.,]+)S)?)?$`)
+
+// isoDurationUnits are the units of the designators of an ISO 8601 duration,
+// in the order of the submatches of isoDurationPattern
+var isoDurationUnits = []Unit{GregorianYears, GregorianMonths, Weeks, Days, Hours, Minutes, Seconds}
+
+// ParseDuration reads an ISO 8601 duration ("P1DT2H30M", "PT0.5S", "-P2W") or a
+// Go duration ("1h30m", "300ms") and returns it in seconds. ISO years and months
+// have no fixed length; they are read as average Gregorian years and months.
+func ParseDuration(s string) (Quantity, error) {
+	s = strings.TrimSpace(s)
+
+	var seconds float64
+	if strings.HasPrefix(strings.TrimLeft(s, "+-"), "P") {
+		rat, err := parseISODuration(s)
+		if err != nil {
+			return Quantity{}, err
+		}
+		seconds, _ = rat.Float64()
+	} else {
+		duration, err := time.ParseDuration(s)
+		if err != nil {
+			return Quantity{}, fmt.Errorf("%w: %q is not an ISO 8601 or Go duration", ErrInvalidQuantity, s)
+		}
+		seconds = duration.Seconds()
+	}
+
+	return Quantity{Value: seconds, Unit: Seconds, Type: Time}, nil
+}
+
+// parseISODuration adds up the components of an ISO 8601 duration exactly
+func parseISODuration(s string) (*big.Rat, error) {
+	match := isoDurationPattern.FindStringSubmatch(s)
+	if match == nil || strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T") {
+		return nil, fmt.Errorf("%w: %q is not an ISO 8601 duration", ErrInvalidQuantity, s)
+	}
+
+	total := new(big.Rat)
+	for i, component := range match[2:] {
+		if component == "" {
+			continue
+		}
+
+		// ISO 8601 allows a comma as the decimal sign
+		value, ok := new(big.Rat).SetString(strings.Replace(component, ",", ".", 1))
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid number %q in %q", ErrInvalidQuantity, component, s)
+		}
+
+		definition := Registry[Time][isoDurationUnits[i]]
+		total.Add(total, value.Mul(value, definition.exact))
+	}
+
+	if match[1] == "-" {
+		total.Neg(total)
+	}
+
+	return total, nil
+}
+
+// FormatISODuration writes value, expressed in a unit of Time, as an ISO 8601
+// duration such as "P1DT2H30M". Years and months have no fixed length, so the
+// largest component written is the day. Fractions of a second are kept to the
+// nanosecond.
+func FormatISODuration(value float64, unit Unit) (string, error) {
+	seconds, err := Convert(Time, unit, Seconds, value, WithoutRounding(), WithSigned())
+	if err != nil {
+		return "", err
+	}
+
+	var iso strings.Builder
+	if seconds < 0 {
+		iso.WriteByte('-')
+		seconds = -seconds
+	}
+	iso.WriteByte('P')
+
+	whole := math.Trunc(seconds)
+	fraction := math.Round((seconds-whole)*1e9) / 1e9
+	if fraction == 1 {
+		whole, fraction = whole+1, 0
+	}
+
+	// Durations may exceed an int64 of seconds, but whole float64 seconds divide exactly
+	days := math.Floor(whole / 86400)
+	total := int64(math.Mod(whole, 86400))
+	hours, minutes := total/3600, total%3600/60
+	rest := float64(total%60) + fraction
+
+	if days > 0 {
+		iso.WriteString(strconv.FormatFloat(days, 'f', -1, 64) + "D")
+	}
+	if hours > 0 || minutes > 0 || rest > 0 || days == 0 {
+		iso.WriteByte('T')
+	}
+	if hours > 0 {
+		iso.WriteString(strconv.FormatInt(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		iso.WriteString(strconv.FormatInt(minutes, 10) + "M")
+	}
+	if rest > 0 || (days == 0 && hours == 0 && minutes == 0) {
+		iso.WriteString(strconv.FormatFloat(rest, 'f', -1, 64) + "S")
+	}
+
+	return iso.String(), nil
+}
+
+// FormatGoDuration writes value, expressed in a unit of Time, as a Go duration
+// such as "1h30m0s", the format read by time.ParseDuration. Durations that do
+// not fit in a time.Duration, about 292 years, are rejected.
+func FormatGoDuration(value float64, unit Unit) (string, error) {
+	seconds, err := Convert(Time, unit, Seconds, value, WithoutRounding(), WithSigned())
+	if err != nil {
+		return "", err
+	}
+
+	if math.Abs(seconds) > float64(math.MaxInt64)/float64(time.Second) {
+		return "", fmt.Errorf("%w: %s %s does not fit in a duration", ErrOutOfDomain, formatValue(value), unit)
+	}
+
+	return time.Duration(math.Round(seconds * float64(time.Second))).String(), nil
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Conversion failures, usable with errors.Is
+var (
+	ErrUnknownUnitType   = errors.New("unknown unit type")
+	ErrUnknownUnit       = errors.New("unknown unit")
+	ErrIncompatibleUnits = errors.New("incompatible units")
+	ErrOutOfDomain       = errors.New("value out of domain")
+	ErrAmbiguousUnit     = errors.New("ambiguous unit")
+)
+
+// UnitError reports which unit or unit type made a conversion fail.
+// It wraps one of the sentinel errors above.
+type UnitError struct {
+	UnitType UnitType
+	Unit     Unit
+	// Actual is the unit type Unit belongs to when it is incompatible with UnitType
+	Actual UnitType
+	Err    error
+}
+
+func (e *UnitError) Error() string {
+	switch {
+	case errors.Is(e.Err, ErrUnknownUnitType):
+		return fmt.Sprintf("%s %q", e.Err, e.UnitType)
+	case errors.Is(e.Err, ErrIncompatibleUnits):
+		return fmt.Sprintf("%s: %q is a %s unit, not %s", e.Err, e.Unit, e.Actual, e.UnitType)
+	case e.UnitType == "":
+		return fmt.Sprintf("%s %q", e.Err, e.Unit)
+	default:
+		return fmt.Sprintf("%s %q for %s", e.Err, e.Unit, e.UnitType)
+	}
+}
+
+func (e *UnitError) Unwrap() error {
+	return e.Err
+}
+
+// DomainError reports a value outside the valid domain of its unit.
+// It wraps ErrOutOfDomain.
+type DomainError struct {
+	UnitType UnitType
+	Unit     Unit
+	Value    float64
+	// Min is the lowest valid value, expressed in Unit. On descending scales
+	// such as Delisle it is the highest valid value.
+	Min        float64
+	Descending bool
+	// Target is set when the value is valid but has no finite equivalent in
+	// Target, such as 0 L/100km in mpg
+	Target Unit
+}
+
+func (e *DomainError) Error() string {
+	if math.IsNaN(e.Value) || math.IsInf(e.Value, 0) {
+		return fmt.Sprintf("%s: %v is not a finite number", ErrOutOfDomain, e.Value)
+	}
+
+	if e.Target != "" {
+		return fmt.Sprintf("%s: %s %s has no finite equivalent in %s", ErrOutOfDomain,
+			formatValue(e.Value), e.Unit, e.Target)
+	}
+
+	if e.Descending {
+		return fmt.Sprintf("%s: %s %s is above the maximum of %s %s for %s", ErrOutOfDomain,
+			formatValue(e.Value), e.Unit, formatValue(e.Min), e.Unit, e.UnitType)
+	}
+
+	return fmt.Sprintf("%s: %s %s is below the minimum of %s %s for %s", ErrOutOfDomain,
+		formatValue(e.Value), e.Unit, formatValue(e.Min), e.Unit, e.UnitType)
+}
+
+func (e *DomainError) Unwrap() error {
+	return ErrOutOfDomain
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// AmbiguousUnitError reports a name that matches several units, such as "t"
+// for both tonnes and short tons. It wraps ErrAmbiguousUnit.
+type AmbiguousUnitError struct {
+	Name       string
+	Candidates []UnitRef
+}
+
+func (e *AmbiguousUnitError) Error() string {
+	candidates := make([]string, len(e.Candidates))
+	for i, candidate := range e.Candidates {
+		candidates[i] = fmt.Sprintf("%s (%s)", candidate.Unit, candidate.Type)
+	}
+
+	return fmt.Sprintf("%s %q: could be %s", ErrAmbiguousUnit, e.Name, strings.Join(candidates, ", "))
+}
+
+func (e *AmbiguousUnitError) Unwrap() error {
+	return ErrAmbiguousUnit
+}
+
+// unknownUnitError tells an unknown unit apart from one that exists in another unit type
+func unknownUnitError(unitType UnitType, unit Unit) error {
+	found, err := Lookup(string(unit))
+	if err == nil && found.Type != unitType {
+		return &UnitError{UnitType: unitType, Unit: unit, Actual: found.Type, Err: ErrIncompatibleUnits}
+	}
+
+	return &UnitError{UnitType: unitType, Unit: unit, Err: ErrUnknownUnit}
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// ExactResult is the outcome of an arbitrary-precision conversion
+type ExactResult struct {
+	Value *big.Rat
+	// Exact is false when the conversion could not be done with rational
+	// arithmetic (affine units such as temperatures) and went through float64.
+	Exact bool
+
+	// turn and angleRange keep an angle normalized once it is rounded, see Decimal
+	turn       *big.Rat
+	angleRange AngleRange
+}
+
+// Decimal formats the result with the given number of decimal places,
+// rounding halves away from zero. Angles normalized with WithAngleRange stay
+// within their range: 359.999° is 0.00°, not 360.00°.
+func (r ExactResult) Decimal(places int) string {
+	if r.turn == nil {
+		return r.Value.FloatString(places)
+	}
+
+	rounded, _ := new(big.Rat).SetString(r.Value.FloatString(places))
+	return normalizeRat(rounded, r.turn, r.angleRange).FloatString(places)
+}
+
+// ConvertRat converts value between two units of the same type without going
+// through float64 whenever both units are defined by exact rational factors.
+// Rounding options are ignored in opts, since the result is never rounded.
+func ConvertRat(unitType UnitType, fromUnit, toUnit Unit, value *big.Rat, opts ...ConvertOption) (ExactResult, error) {
+	options := newConvertOptions(opts...)
+	options.NoRounding = true
+	float, _ := value.Float64()
+	// Densities are measured, so substance conversions are never exact
+	if converted, ok, err := options.convertSubstance(unitType, fromUnit, toUnit, float); ok || err != nil {
+		if err != nil {
+			return ExactResult{}, err
+		}
+		return ExactResult{Value: new(big.Rat).SetFloat64(converted), Exact: false}, nil
+	}
+
+	from, to, err := options.prepare(unitType, fromUnit, toUnit)
+	if err != nil {
+		return ExactResult{}, err
+	}
+
+	// Intervals are differences, which absolute zero does not bound
+	if !options.Interval {
+		if err := checkDomainRat(unitType, fromUnit, from, value, options.Signed); err != nil {
+			return ExactResult{}, err
+		}
+	}
+
+	var result ExactResult
+	switch {
+	// Tables are read in float64, and between entries they are interpolated
+	case from.Table != nil || to.Table != nil:
+		converted, _, err := options.convertTable(unitType, fromUnit, toUnit, from, to, float)
+		if err != nil {
+			return ExactResult{}, err
+		}
+		result = ExactResult{Value: new(big.Rat).SetFloat64(converted), Exact: false}
+	case fromUnit == toUnit && options.GaugeFrom == options.GaugeTo:
+		result = ExactResult{Value: new(big.Rat).Set(value), Exact: true}
+	case from.exact == nil || to.exact == nil:
+		converted := to.FromBase(from.ToBase(float))
+		if err := checkFinite(unitType, fromUnit, toUnit, float, converted); err != nil {
+			return ExactResult{}, err
+		}
+		result = ExactResult{Value: new(big.Rat).SetFloat64(converted), Exact: false}
+	case from.Inverse || to.Inverse:
+		exact, err := convertInverse(unitType, fromUnit, toUnit, from, to, value)
+		if err != nil {
+			return ExactResult{}, err
+		}
+		result = ExactResult{Value: exact, Exact: true}
+	default:
+		// Only gauge readings give exact units an offset, and it is a whole number of pascals
+		exact := new(big.Rat).Mul(value, from.exact)
+		exact.Add(exact, new(big.Rat).SetFloat64(from.Offset))
+		exact.Sub(exact, new(big.Rat).SetFloat64(to.Offset))
+		exact.Quo(exact, to.exact)
+		result = ExactResult{Value: exact, Exact: true}
+	}
+
+	return options.normalizeResult(unitType, to, result)
+}
+
+// convertInverse converts exactly between units of which at least one is
+// inversely proportional to the base unit
+func convertInverse(unitType UnitType, fromUnit, toUnit Unit, from, to UnitDefinition, value *big.Rat) (*big.Rat, error) {
+	base := new(big.Rat).Mul(value, from.exact)
+	if from.Inverse {
+		if value.Sign() == 0 {
+			float, _ := value.Float64()
+			return nil, &DomainError{UnitType: unitType, Unit: fromUnit, Value: float, Target: toUnit}
+		}
+		base.Quo(from.exact, value)
+	}
+
+	if !to.Inverse {
+		return base.Quo(base, to.exact), nil
+	}
+
+	if base.Sign() == 0 {
+		float, _ := value.Float64()
+		return nil, &DomainError{UnitType: unitType, Unit: fromUnit, Value: float, Target: toUnit}
+	}
+	return base.Quo(to.exact, base), nil
+}
+
+// ConvertDecimal is ConvertRat for values written as decimal strings ("1.5", "2e-30")
+// or fractions ("1/3")
+func ConvertDecimal(unitType UnitType, fromUnit, toUnit Unit, value string, opts ...ConvertOption) (ExactResult, error) {
+	rat, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return ExactResult{}, fmt.Errorf("invalid decimal value %q", value)
+	}
+
+	return ConvertRat(unitType, fromUnit, toUnit, rat, opts...)
+}
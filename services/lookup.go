@@ -0,0 +1,94 @@
+package services
+
+import (
+	"sort"
+	"strings"
+)
+
+// UnitRef identifies a unit within its unit type
+type UnitRef struct {
+	Type UnitType
+	Unit Unit
+}
+
+// Lookup resolves a unit name, symbol or alias ("m", "metre", "Meters", "°F", "lbs")
+// to the unit it stands for, across every unit type. Exact matches win over
+// case-insensitive ones. A name matching several units is reported with an
+// *AmbiguousUnitError rather than guessed.
+func Lookup(name string) (UnitRef, error) {
+	return lookup("", name)
+}
+
+// LookupIn is Lookup restricted to the units of unitType
+func LookupIn(unitType UnitType, name string) (Unit, error) {
+	if _, ok := Registry[unitType]; !ok {
+		return "", &UnitError{UnitType: unitType, Err: ErrUnknownUnitType}
+	}
+
+	found, err := lookup(unitType, name)
+	return found.Unit, err
+}
+
+// lookup searches unitType, or every unit type when it is empty
+func lookup(unitType UnitType, name string) (UnitRef, error) {
+	name = strings.TrimSpace(name)
+
+	candidates := matchUnits(unitType, func(candidate string) bool { return candidate == name })
+	if len(candidates) == 0 {
+		candidates = matchUnits(unitType, func(candidate string) bool { return strings.EqualFold(candidate, name) })
+	}
+
+	switch len(candidates) {
+	case 0:
+		return UnitRef{}, &UnitError{UnitType: unitType, Unit: Unit(name), Err: ErrUnknownUnit}
+	case 1:
+		return candidates[0], nil
+	default:
+		return UnitRef{}, &AmbiguousUnitError{Name: name, Candidates: candidates}
+	}
+}
+
+// matchUnits lists the units whose name, symbol or one of whose aliases satisfies match
+func matchUnits(unitType UnitType, match func(string) bool) []UnitRef {
+	var refs []UnitRef
+	for candidateType := range Registry {
+		if unitType != "" && candidateType != unitType {
+			continue
+		}
+
+		for unit, definition := range allUnits(candidateType) {
+			if definition.matches(unit, match) {
+				refs = append(refs, UnitRef{Type: candidateType, Unit: unit})
+			}
+		}
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Type != refs[j].Type {
+			return refs[i].Type < refs[j].Type
+		}
+		return refs[i].Unit < refs[j].Unit
+	})
+
+	return refs
+}
+
+func (d UnitDefinition) matches(unit Unit, match func(string) bool) bool {
+	if match(string(unit)) || (d.Symbol != "" && match(d.Symbol)) {
+		return true
+	}
+
+	for _, alternate := range d.Alternates {
+		if match(alternate) {
+			return true
+		}
+	}
+
+	for _, alias := range d.Aliases {
+		if match(alias) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+)
+
+// ErrMissingParameter is returned when a conversion needs a context parameter it was not given
+var ErrMissingParameter = errors.New("missing parameter")
+
+// Parameter is a value from the context of a conversion that the size of some
+// units depends on, such as the resolution of the screen for pixels
+type Parameter string
+
+// Supported parameters
+const (
+	DPI          Parameter = "dpi"            // pixels per inch of the screen
+	FontSize     Parameter = "font-size"      // pixels per em, the font size of the element
+	RootFontSize Parameter = "root-font-size" // pixels per rem, the font size of the root element
+	Density      Parameter = "density"        // pixels per Android dp, the dpi of the screen over 160
+	FontScale    Parameter = "font-scale"     // Android dp per sp, the text size preference of the user
+)
+
+// WithParameter gives the value of a context parameter, such as
+// WithParameter(DPI, 96) to convert pixels to inches
+func WithParameter(parameter Parameter, value float64) ConvertOption {
+	return func(o *ConvertOptions) {
+		parameters := maps.Clone(o.Parameters)
+		if parameters == nil {
+			parameters = map[Parameter]float64{}
+		}
+		parameters[parameter] = value
+		o.Parameters = parameters
+	}
+}
+
+// Scaled returns a copy of the definition whose size is multiplied by the value
+// of parameter raised to exponent: a pixel is Rational(metersPerInch).Scaled(DPI, -1)
+func (d UnitDefinition) Scaled(parameter Parameter, exponent int) UnitDefinition {
+	parameters := maps.Clone(d.Parameters)
+	if parameters == nil {
+		parameters = map[Parameter]int{}
+	}
+	parameters[parameter] += exponent
+	d.Parameters = parameters
+	return d
+}
+
+// ParameterError reports the parameters a conversion needs and was not given.
+// It wraps ErrMissingParameter.
+type ParameterError struct {
+	From       Unit
+	To         Unit
+	Parameters []Parameter
+}
+
+func (e *ParameterError) Error() string {
+	names := make([]string, len(e.Parameters))
+	for i, parameter := range e.Parameters {
+		names[i] = string(parameter)
+	}
+
+	return fmt.Sprintf("%s: converting %s to %s needs %s", ErrMissingParameter, e.From, e.To, strings.Join(names, ", "))
+}
+
+func (e *ParameterError) Unwrap() error {
+	return ErrMissingParameter
+}
+
+// scale sizes the units of a conversion with the parameters of the options.
+// Only the parameters that do not cancel out are needed: ems convert to pixels
+// with the font size alone, even though both depend on the DPI.
+func (o ConvertOptions) scale(fromUnit, toUnit Unit, from, to UnitDefinition) (UnitDefinition, UnitDefinition, error) {
+	if len(from.Parameters) == 0 && len(to.Parameters) == 0 {
+		return from, to, nil
+	}
+
+	all := map[Parameter]int{}
+	maps.Copy(all, from.Parameters)
+	maps.Copy(all, to.Parameters)
+
+	var missing []Parameter
+	scaled := false
+	for _, parameter := range slices.Sorted(maps.Keys(all)) {
+		if from.Parameters[parameter] == to.Parameters[parameter] {
+			continue
+		}
+
+		value, ok := o.Parameters[parameter]
+		if !ok {
+			missing = append(missing, parameter)
+			continue
+		}
+		if !(value > 0) || math.IsInf(value, 0) {
+			return from, to, fmt.Errorf("%w: %s must be a positive number, not %v", ErrOutOfDomain, parameter, value)
+		}
+
+		from.Factor *= math.Pow(value, float64(from.Parameters[parameter]))
+		to.Factor *= math.Pow(value, float64(to.Parameters[parameter]))
+		scaled = true
+	}
+
+	if len(missing) > 0 {
+		return from, to, &ParameterError{From: fromUnit, To: toUnit, Parameters: missing}
+	}
+
+	// Parameters are measured, so scaled units are no longer exact
+	if scaled {
+		from.exact, to.exact = nil, nil
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidQuantity is returned when a quantity expression cannot be parsed
+var ErrInvalidQuantity = errors.New("invalid quantity")
+
+// Quantity is a value expressed in a unit
+type Quantity struct {
+	Value float64
+	Unit  Unit
+	Type  UnitType
+}
+
+// Conversion is a parsed conversion query, such as "10 km to mi"
+type Conversion struct {
+	From Quantity
+	To   Unit
+}
+
+var (
+	numberPattern    = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?`)
+	separatorPattern = regexp.MustCompile(`(?i)\s+(?:to|in|into|as)\s+|\s*(?:->|→|=)\s*`)
+)
+
+// Parse reads a quantity such as "1.5e3 km", "72°F" or "5 ft 3 in".
+// Units are resolved with Lookup. When several terms are given they must share
+// a unit type; they are added up and expressed in the unit of the first term.
+// Only proportional units add up: terms in affine units such as °C, inverse
+// units such as L/100km or table-backed units are rejected.
+func Parse(input string) (Quantity, error) {
+	terms, err := splitTerms(input)
+	if err != nil {
+		return Quantity{}, err
+	}
+
+	var quantity Quantity
+	for i, term := range terms {
+		ref, err := Lookup(term.unit)
+		if err != nil {
+			return Quantity{}, err
+		}
+
+		if len(terms) > 1 {
+			definition, _ := Definition(ref.Type, ref.Unit)
+			if definition.Offset != 0 || definition.Inverse || definition.Table != nil {
+				return Quantity{}, fmt.Errorf("%w: %q readings cannot be added up", ErrIncompatibleUnits, term.unit)
+			}
+		}
+
+		if i == 0 {
+			quantity = Quantity{Value: term.value, Unit: ref.Unit, Type: ref.Type}
+			continue
+		}
+
+		if ref.Type != quantity.Type {
+			return Quantity{}, &UnitError{UnitType: quantity.Type, Unit: ref.Unit, Actual: ref.Type, Err: ErrIncompatibleUnits}
+		}
+
+		conversion, err := Converter(ref.Type, ref.Unit, quantity.Unit)
+		if err != nil {
+			return Quantity{}, err
+		}
+		quantity.Value += conversion(term.value)
+	}
+
+	return quantity, nil
+}
+
+// ParseConversion reads a whole conversion query: a quantity, a separator
+// ("to", "in", "into", "as", "->", "→" or "=") and the unit to convert to
+func ParseConversion(input string) (Conversion, error) {
+	separators := findSeparators(input)
+	if len(separators) == 0 {
+		return Conversion{}, fmt.Errorf("%w: %q has no target unit", ErrInvalidQuantity, input)
+	}
+
+	// "in" is both a separator and the inch symbol, so try the rightmost separator first
+	var err error
+	for i := len(separators) - 1; i >= 0; i-- {
+		separator := separators[i]
+
+		var from Quantity
+		from, err = Parse(input[:separator[0]])
+		if err != nil {
+			continue
+		}
+
+		target := strings.TrimSpace(input[separator[1]:])
+
+		var to Unit
+		to, err = LookupIn(from.Type, target)
+		if errors.Is(err, ErrUnknownUnit) {
+			err = unknownUnitError(from.Type, Unit(target))
+		}
+		if err != nil {
+			continue
+		}
+
+		return Conversion{From: from, To: to}, nil
+	}
+
+	return Conversion{}, err
+}
+
+// Convert performs the parsed conversion
+func (c Conversion) Convert(opts ...ConvertOption) (float64, error) {
+	return Convert(c.From.Type, c.From.Unit, c.To, c.From.Value, opts...)
+}
+
+// findSeparators lists every separator in input, including overlapping ones:
+// in "12 in in cm" both " in " share a space.
+func findSeparators(input string) [][]int {
+	var separators [][]int
+	for offset := 0; offset < len(input); {
+		match := separatorPattern.FindStringIndex(input[offset:])
+		if match == nil {
+			break
+		}
+
+		separators = append(separators, []int{offset + match[0], offset + match[1]})
+		_, size := utf8.DecodeRuneInString(input[offset+match[0]:])
+		offset += match[0] + size
+	}
+
+	return separators
+}
+
+type term struct {
+	value float64
+	unit  string
+}
+
+// splitTerms breaks "5 ft 3 in" into value/unit pairs. A unit runs until the
+// next number, which must follow a space or a symbol such as ' so that units
+// containing digits, as "m2" or "L/100km", are not cut short.
+func splitTerms(input string) ([]term, error) {
+	rest := strings.TrimSpace(input)
+	if rest == "" {
+		return nil, fmt.Errorf("%w: empty input", ErrInvalidQuantity)
+	}
+
+	var terms []term
+	for rest != "" {
+		number := numberPattern.FindString(rest)
+		if number == "" {
+			return nil, fmt.Errorf("%w: expected a number at %q", ErrInvalidQuantity, rest)
+		}
+
+		value, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidQuantity, err)
+		}
+
+		rest = strings.TrimLeftFunc(rest[len(number):], unicode.IsSpace)
+		end := unitEnd(rest)
+		unit := strings.TrimSpace(rest[:end])
+		if unit == "" {
+			return nil, fmt.Errorf("%w: %q has no unit", ErrInvalidQuantity, number)
+		}
+
+		terms = append(terms, term{value: value, unit: unit})
+		rest = strings.TrimSpace(rest[end:])
+	}
+
+	return terms, nil
+}
+
+// unitEnd returns the index where the unit at the start of s ends
+func unitEnd(s string) int {
+	var previous rune
+	for i, r := range s {
+		startsNumber := unicode.IsDigit(r) || ((r == '+' || r == '-' || r == '.') && numberPattern.MatchString(s[i:]))
+		continuesUnit := unicode.IsLetter(previous) || unicode.IsDigit(previous) || previous == '/'
+		if i > 0 && startsNumber && !continuesUnit {
+			return i
+		}
+		previous = r
+	}
+
+	return len(s)
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Prefix is a multiplier applied to a unit, such as kilo (10³) or kibi (2¹⁰)
+type Prefix struct {
+	Name     string
+	Symbol   string
+	Radix    int64
+	Exponent int
+	// Alternates are other accepted spellings of Symbol, e.g. "u" for "µ"
+	Alternates []string
+}
+
+// PrefixSet selects which prefixes a unit accepts
+type PrefixSet int
+
+// Prefix sets a unit can opt into
+const (
+	SISubmultiplePrefixed PrefixSet = 1 << iota // quecto (10⁻³⁰) to deci (10⁻¹)
+	SIMultiplePrefixed                          // deca (10¹) to quetta (10³⁰)
+	BinaryPrefixed                              // kibi (2¹⁰) to yobi (2⁸⁰), for data units
+
+	SIPrefixed = SISubmultiplePrefixed | SIMultiplePrefixed // quecto (10⁻³⁰) to quetta (10³⁰)
+)
+
+// SIPrefixes are the decimal prefixes of the SI, from the smallest to the largest
+var SIPrefixes = []Prefix{
+	{Name: "quecto", Symbol: "q", Radix: 10, Exponent: -30},
+	{Name: "ronto", Symbol: "r", Radix: 10, Exponent: -27},
+	{Name: "yocto", Symbol: "y", Radix: 10, Exponent: -24},
+	{Name: "zepto", Symbol: "z", Radix: 10, Exponent: -21},
+	{Name: "atto", Symbol: "a", Radix: 10, Exponent: -18},
+	{Name: "femto", Symbol: "f", Radix: 10, Exponent: -15},
+	{Name: "pico", Symbol: "p", Radix: 10, Exponent: -12},
+	{Name: "nano", Symbol: "n", Radix: 10, Exponent: -9},
+	{Name: "micro", Symbol: "µ", Radix: 10, Exponent: -6, Alternates: []string{"μ", "u"}},
+	{Name: "milli", Symbol: "m", Radix: 10, Exponent: -3},
+	{Name: "centi", Symbol: "c", Radix: 10, Exponent: -2},
+	{Name: "deci", Symbol: "d", Radix: 10, Exponent: -1},
+	{Name: "deca", Symbol: "da", Radix: 10, Exponent: 1},
+	{Name: "hecto", Symbol: "h", Radix: 10, Exponent: 2},
+	{Name: "kilo", Symbol: "k", Radix: 10, Exponent: 3},
+	{Name: "mega", Symbol: "M", Radix: 10, Exponent: 6},
+	{Name: "giga", Symbol: "G", Radix: 10, Exponent: 9},
+	{Name: "tera", Symbol: "T", Radix: 10, Exponent: 12},
+	{Name: "peta", Symbol: "P", Radix: 10, Exponent: 15},
+	{Name: "exa", Symbol: "E", Radix: 10, Exponent: 18},
+	{Name: "zetta", Symbol: "Z", Radix: 10, Exponent: 21},
+	{Name: "yotta", Symbol: "Y", Radix: 10, Exponent: 24},
+	{Name: "ronna", Symbol: "R", Radix: 10, Exponent: 27},
+	{Name: "quetta", Symbol: "Q", Radix: 10, Exponent: 30},
+}
+
+// BinaryPrefixes are the IEC prefixes for powers of 1024
+var BinaryPrefixes = []Prefix{
+	{Name: "kibi", Symbol: "Ki", Radix: 2, Exponent: 10},
+	{Name: "mebi", Symbol: "Mi", Radix: 2, Exponent: 20},
+	{Name: "gibi", Symbol: "Gi", Radix: 2, Exponent: 30},
+	{Name: "tebi", Symbol: "Ti", Radix: 2, Exponent: 40},
+	{Name: "pebi", Symbol: "Pi", Radix: 2, Exponent: 50},
+	{Name: "exbi", Symbol: "Ei", Radix: 2, Exponent: 60},
+	{Name: "zebi", Symbol: "Zi", Radix: 2, Exponent: 70},
+	{Name: "yobi", Symbol: "Yi", Radix: 2, Exponent: 80},
+}
+
+// WithPrefixes returns a copy of the definition that accepts the given prefixes
+func (d UnitDefinition) WithPrefixes(prefixes PrefixSet) UnitDefinition {
+	d.Prefixes = prefixes
+	return d
+}
+
+// prefixes lists the prefixes of the set
+func (s PrefixSet) prefixes() []Prefix {
+	var prefixes []Prefix
+	for _, prefix := range SIPrefixes {
+		if (prefix.Exponent < 0 && s&SISubmultiplePrefixed != 0) || (prefix.Exponent > 0 && s&SIMultiplePrefixed != 0) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if s&BinaryPrefixed != 0 {
+		prefixes = append(prefixes, BinaryPrefixes...)
+	}
+	return prefixes
+}
+
+// Factor is the exact multiplier of the prefix
+func (p Prefix) Factor() *big.Rat {
+	exponent := p.Exponent
+	if exponent < 0 {
+		exponent = -exponent
+	}
+
+	power := new(big.Int).Exp(big.NewInt(p.Radix), big.NewInt(int64(exponent)), nil)
+	if p.Exponent < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), power)
+	}
+	return new(big.Rat).SetInt(power)
+}
+
+// apply builds the definition of the prefixed unit, e.g. kilometers from meters
+func (p Prefix) apply(unit Unit, d UnitDefinition) (Unit, UnitDefinition) {
+	factor := p.Factor()
+	prefixed := UnitDefinition{Offset: d.Offset, Approximate: d.Approximate}
+
+	if d.exact != nil {
+		prefixed.exact = factor.Mul(factor, d.exact)
+		prefixed.Factor, _ = prefixed.exact.Float64()
+	} else {
+		float, _ := factor.Float64()
+		prefixed.Factor = float * d.Factor
+	}
+
+	if d.Symbol != "" {
+		prefixed.Symbol = p.Symbol + d.Symbol
+
+		// Every spelling of the prefix goes with every spelling of the symbol: µl, ul, μL, ...
+		for i, symbol := range append([]string{d.Symbol}, d.Alternates...) {
+			if i > 0 {
+				prefixed.Alternates = append(prefixed.Alternates, p.Symbol+symbol)
+			}
+			for _, alternate := range p.Alternates {
+				prefixed.Alternates = append(prefixed.Alternates, alternate+symbol)
+			}
+		}
+	}
+	for _, alias := range d.Aliases {
+		if isWord(alias) {
+			prefixed.Aliases = append(prefixed.Aliases, p.Name+alias)
+		}
+	}
+
+	return Unit(p.Name) + unit, prefixed
+}
+
+// Definition returns the definition of unit within unitType. Prefixed units
+// such as "micrometers" or "kilowatt-hours" are generated on demand from the
+// units that accept prefixes.
+func Definition(unitType UnitType, unit Unit) (UnitDefinition, bool) {
+	definition, ok := allUnits(unitType)[unit]
+	return definition, ok
+}
+
+// Units lists every unit of unitType, prefixed units included, from the smallest to the largest
+func Units(unitType UnitType) []Unit {
+	all := allUnits(unitType)
+
+	units := make([]Unit, 0, len(all))
+	for unit := range all {
+		units = append(units, unit)
+	}
+
+	sort.Slice(units, func(i, j int) bool {
+		a, b := all[units[i]], all[units[j]]
+		if a.Factor != b.Factor {
+			return a.Factor < b.Factor
+		}
+		return units[i] < units[j]
+	})
+
+	return units
+}
+
+// expandedUnits holds the units of every fixed unit type together with their
+// prefixed forms, since big.Rat prefixes are too costly to derive on each
+// lookup. A type is expanded again when units are added to it or removed
+// from it in the Registry.
+var expandedUnits struct {
+	sync.RWMutex
+	types map[UnitType]expandedType
+}
+
+// expandedType is the expansion of the registered units of a type, of which
+// there were size when it was built
+type expandedType struct {
+	size  int
+	units map[Unit]UnitDefinition
+}
+
+// allUnits returns the registered units of unitType together with their
+// prefixed forms. The map is shared and must not be modified.
+func allUnits(unitType UnitType) map[Unit]UnitDefinition {
+	// Currencies follow the rates in use, and take no prefixes
+	if unitType == Currency {
+		return currencyUnits()
+	}
+
+	units, ok := Registry[unitType]
+	if !ok {
+		return nil
+	}
+
+	expandedUnits.RLock()
+	expanded, ok := expandedUnits.types[unitType]
+	expandedUnits.RUnlock()
+	if ok && expanded.size == len(units) {
+		return expanded.units
+	}
+
+	expanded = expandedType{size: len(units), units: expandUnits(units)}
+
+	expandedUnits.Lock()
+	defer expandedUnits.Unlock()
+
+	if expandedUnits.types == nil {
+		expandedUnits.types = make(map[UnitType]expandedType, len(Registry))
+	}
+	expandedUnits.types[unitType] = expanded
+	return expanded.units
+}
+
+// expandUnits adds the prefixed forms of units to them
+func expandUnits(units map[Unit]UnitDefinition) map[Unit]UnitDefinition {
+	all := make(map[Unit]UnitDefinition, len(units))
+	for unit, definition := range units {
+		all[unit] = definition
+
+		for _, prefix := range definition.Prefixes.prefixes() {
+			prefixedUnit, prefixed := prefix.apply(unit, definition)
+			if _, registered := units[prefixedUnit]; !registered {
+				all[prefixedUnit] = prefixed
+			}
+		}
+	}
+
+	return all
+}
+
+// isWord reports aliases that are spelled-out names ("metre", "watt-hour"),
+// which take the name of a prefix
+func isWord(s string) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool { return (r < 'a' || r > 'z') && r != '-' }) < 0
+}
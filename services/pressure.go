@@ -0,0 +1,44 @@
+package services
+
+import "fmt"
+
+// StandardAtmosphere is the atmospheric pressure, in pascals, that gauge
+// pressures are measured against
+const StandardAtmosphere = 101325
+
+// WithGaugeFrom reads the value as a gauge pressure, the pressure above the
+// atmosphere that a tyre gauge shows, instead of an absolute pressure
+func WithGaugeFrom() ConvertOption {
+	return func(o *ConvertOptions) {
+		o.GaugeFrom = true
+	}
+}
+
+// WithGaugeTo writes the result as a gauge pressure instead of an absolute pressure
+func WithGaugeTo() ConvertOption {
+	return func(o *ConvertOptions) {
+		o.GaugeTo = true
+	}
+}
+
+// gauge turns the definitions of a conversion into their gauge counterparts
+// when asked to: a gauge unit is its absolute unit shifted by one atmosphere,
+// the same way Celsius is Kelvin shifted by 273.15.
+func (o ConvertOptions) gauge(unitType UnitType, from, to UnitDefinition) (UnitDefinition, UnitDefinition, error) {
+	if !o.GaugeFrom && !o.GaugeTo {
+		return from, to, nil
+	}
+
+	if unitType != Pressure {
+		return from, to, fmt.Errorf("%w: gauge readings only apply to pressure, not %s", ErrIncompatibleUnits, unitType)
+	}
+
+	if o.GaugeFrom {
+		from.Offset += StandardAtmosphere
+	}
+	if o.GaugeTo {
+		to.Offset += StandardAtmosphere
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// RoundingMode defines how a converted value is rounded
+type RoundingMode int
+
+// Supported rounding modes
+const (
+	RoundHalfUp   RoundingMode = iota // halves away from zero: 2.5 -> 3, -2.5 -> -3
+	RoundHalfEven                     // halves to the even neighbour: 2.5 -> 2, 3.5 -> 4
+	RoundFloor                        // towards negative infinity
+	RoundCeil                         // towards positive infinity
+	RoundTruncate                     // towards zero
+)
+
+// DefaultDecimals is the number of decimal places Convert rounds to unless told otherwise
+const DefaultDecimals = 2
+
+// ConvertOptions controls how Convert reads its value, adjusts the units it
+// converts between (gauge readings, exchange rates, substances, intervals,
+// context parameters and table lookups) and rounds its result
+type ConvertOptions struct {
+	// Signed accepts negative values for unit types that allow a signed
+	// displacement, see Domain
+	Signed bool
+	// Decimals is the number of decimal places to keep. Negative values round
+	// to tens, hundreds, and so on.
+	Decimals int
+	// SignificantFigures, when greater than zero, takes precedence over Decimals
+	SignificantFigures int
+	// Mode is the rounding mode applied at the chosen precision
+	Mode RoundingMode
+	// NoRounding returns the converted value untouched
+	NoRounding bool
+	// GaugeFrom and GaugeTo read the value and write the result as gauge
+	// pressures, relative to the atmosphere, see WithGaugeFrom
+	GaugeFrom bool
+	GaugeTo   bool
+	// AngleRange normalizes converted angles, see WithAngleRange
+	AngleRange AngleRange
+	// RateDate converts currencies at past rates, see WithRateDate
+	RateDate time.Time
+	// rateTable is the snapshot of the rates in use that a currency conversion
+	// reads all of its rates from, taken once per conversion
+	rateTable *RateTable
+	// Substance converts volumes to weights and back, see WithSubstance
+	Substance string
+	// Interval converts temperatures as differences, see WithInterval
+	Interval bool
+	// Parameters holds the context parameters some units need, see WithParameter
+	Parameters map[Parameter]float64
+	// TableLookup reads values between the entries of table-backed units, see WithTableLookup
+	TableLookup TableLookup
+}
+
+// ConvertOption customizes a single call to Convert
+type ConvertOption func(*ConvertOptions)
+
+// WithDecimals rounds the result to n decimal places
+func WithDecimals(n int) ConvertOption {
+	return func(o *ConvertOptions) {
+		o.Decimals = n
+		o.SignificantFigures = 0
+		o.NoRounding = false
+	}
+}
+
+// WithSignificantFigures rounds the result to n significant figures
+func WithSignificantFigures(n int) ConvertOption {
+	return func(o *ConvertOptions) {
+		o.SignificantFigures = n
+		o.NoRounding = false
+	}
+}
+
+// WithRoundingMode selects the rounding mode applied to the result
+func WithRoundingMode(mode RoundingMode) ConvertOption {
+	return func(o *ConvertOptions) {
+		o.Mode = mode
+	}
+}
+
+// WithoutRounding returns the result at full float64 precision
+func WithoutRounding() ConvertOption {
+	return func(o *ConvertOptions) {
+		o.NoRounding = true
+	}
+}
+
+// WithSigned accepts negative lengths, weights and other signable quantities
+func WithSigned() ConvertOption {
+	return func(o *ConvertOptions) {
+		o.Signed = true
+	}
+}
+
+// newConvertOptions applies opts on top of the defaults: two decimal places, half up
+func newConvertOptions(opts ...ConvertOption) ConvertOptions {
+	options := ConvertOptions{Decimals: DefaultDecimals, Mode: RoundHalfUp}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// Round rounds value according to the options. The decimal form of value is
+// rounded rather than its binary expansion, so 1.005 rounds up to 1.01 as
+// written even though the nearest float64 is slightly below it.
+func (o ConvertOptions) Round(value float64) float64 {
+	if o.NoRounding || value == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+
+	magnitude := int(math.Floor(math.Log10(math.Abs(value))))
+	decimals := o.Decimals
+	if o.SignificantFigures > 0 {
+		decimals = o.SignificantFigures - 1 - magnitude
+	}
+
+	if decimals < -maxDecimalExponent || decimals > maxDecimalExponent {
+		// the requested precision is finer or coarser than float64 can hold
+		return value
+	}
+
+	// Conversions leave float64 noise past the 15 significant digits float64
+	// holds in decimal: 226.8/16 g comes out as 14.174999999999999 g. Coarser
+	// precisions read value at 15 digits, finer ones at its shortest form.
+	digits := -1
+	if magnitude+1+decimals < float64Digits {
+		digits = float64Digits
+	}
+
+	decimal, _ := new(big.Rat).SetString(strconv.FormatFloat(value, 'g', digits, 64))
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(decimals))), nil))
+	if decimals < 0 {
+		scale.Inv(scale)
+	}
+
+	rounded := new(big.Rat).SetInt(o.Mode.round(decimal.Mul(decimal, scale)))
+	result, _ := rounded.Quo(rounded, scale).Float64()
+	return result
+}
+
+const (
+	// float64Digits is the number of significant decimal digits float64 always holds
+	float64Digits = 15
+	// maxDecimalExponent bounds the decimal exponents of finite float64 values
+	maxDecimalExponent = 330
+)
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// round rounds value to an integer
+func (m RoundingMode) round(value *big.Rat) *big.Int {
+	// quotient is value truncated towards zero; away is one step further from zero
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	away := new(big.Int).Add(quotient, big.NewInt(int64(value.Sign())))
+	// the remainder against half of the denominator
+	half := new(big.Int).Abs(remainder)
+	half.Lsh(half, 1)
+	halfway := half.Cmp(value.Denom())
+
+	switch m {
+	case RoundHalfEven:
+		if halfway > 0 || halfway == 0 && quotient.Bit(0) == 1 {
+			return away
+		}
+		return quotient
+	case RoundFloor:
+		if value.Sign() < 0 {
+			return away
+		}
+		return quotient
+	case RoundCeil:
+		if value.Sign() > 0 {
+			return away
+		}
+		return quotient
+	case RoundTruncate:
+		return quotient
+	default:
+		if halfway >= 0 {
+			return away
+		}
+		return quotient
+	}
+}
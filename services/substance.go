@@ -0,0 +1,152 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownSubstance is returned when WithSubstance names no known substance
+var ErrUnknownSubstance = errors.New("unknown substance")
+
+// Substance is an ingredient whose volume and weight convert through its density
+type Substance struct {
+	// Density in grams per milliliter
+	Density float64
+	Aliases []string
+}
+
+// gramsPerUSCup gives the density of an ingredient from the weight of a US cup
+// of it, the way baking tables list dry ingredients
+func gramsPerUSCup(grams float64) float64 {
+	return grams / (Rational(cubicMetersPerUSCup).Factor * millilitersPerCubicMeter)
+}
+
+const millilitersPerCubicMeter = 1e6
+
+// Substances holds the densities of common ingredients. Dry ingredients are
+// spooned and leveled, except brown sugar which is packed; butter follows the
+// US convention of a 113.4 g stick per half cup.
+var Substances = map[string]Substance{
+	"water":             {Density: 1},
+	"milk":              {Density: 1.03},
+	"vegetable-oil":     {Density: 0.92, Aliases: []string{"oil", "vegetable oil"}},
+	"honey":             {Density: 1.42},
+	"butter":            {Density: gramsPerUSCup(226.8)},
+	"flour":             {Density: gramsPerUSCup(125), Aliases: []string{"all-purpose flour", "plain flour"}},
+	"bread-flour":       {Density: gramsPerUSCup(130), Aliases: []string{"bread flour"}},
+	"whole-wheat-flour": {Density: gramsPerUSCup(120), Aliases: []string{"whole wheat flour", "wholemeal flour"}},
+	"sugar":             {Density: gramsPerUSCup(200), Aliases: []string{"granulated sugar", "white sugar"}},
+	"brown-sugar":       {Density: gramsPerUSCup(220), Aliases: []string{"brown sugar"}},
+	"powdered-sugar":    {Density: gramsPerUSCup(120), Aliases: []string{"powdered sugar", "icing sugar", "confectioners sugar"}},
+	"cocoa-powder":      {Density: gramsPerUSCup(85), Aliases: []string{"cocoa", "cocoa powder"}},
+	"cornstarch":        {Density: gramsPerUSCup(128), Aliases: []string{"cornflour", "corn starch"}},
+	"salt":              {Density: gramsPerUSCup(288), Aliases: []string{"table salt"}},
+	"rice":              {Density: gramsPerUSCup(185), Aliases: []string{"white rice"}},
+	"rolled-oats":       {Density: gramsPerUSCup(90), Aliases: []string{"oats", "rolled oats"}},
+}
+
+// SubstanceNames lists the names of the known substances in alphabetical order
+func SubstanceNames() []string {
+	names := make([]string, 0, len(Substances))
+	for name := range Substances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupSubstance finds a substance by its name or one of its aliases, ignoring case
+func LookupSubstance(name string) (Substance, error) {
+	name = strings.TrimSpace(name)
+	if substance, ok := Substances[name]; ok {
+		return substance, nil
+	}
+
+	for candidate, substance := range Substances {
+		if strings.EqualFold(candidate, name) {
+			return substance, nil
+		}
+		for _, alias := range substance.Aliases {
+			if strings.EqualFold(alias, name) {
+				return substance, nil
+			}
+		}
+	}
+
+	return Substance{}, fmt.Errorf("%w %q", ErrUnknownSubstance, name)
+}
+
+// WithSubstance converts between volumes and weights of the named substance,
+// e.g. Convert(Volume, USCups, Grams, 2, WithSubstance("flour"))
+func WithSubstance(name string) ConvertOption {
+	return func(o *ConvertOptions) {
+		o.Substance = name
+	}
+}
+
+// substanceTypes pairs the unit types a density converts between
+var substanceTypes = map[UnitType]UnitType{Volume: Weight, Weight: Volume}
+
+// substanceTarget returns the unit type toUnit belongs to when a substance
+// conversion crosses from unitType to it. Units of unitType itself convert as usual.
+func substanceTarget(unitType UnitType, toUnit Unit) (UnitType, bool) {
+	target, ok := substanceTypes[unitType]
+	if !ok {
+		return "", false
+	}
+
+	if _, err := resolve(unitType, toUnit); err == nil {
+		return "", false
+	}
+	if _, err := resolve(target, toUnit); err != nil {
+		return "", false
+	}
+
+	return target, true
+}
+
+// convertSubstance converts value from a volume to a weight or back through the
+// density of the substance of the options. It reports false, leaving the
+// conversion to the caller, when there is no substance or no change of unit type.
+func (o ConvertOptions) convertSubstance(unitType UnitType, fromUnit, toUnit Unit, value float64) (float64, bool, error) {
+	if o.Substance == "" {
+		return 0, false, nil
+	}
+
+	substance, err := LookupSubstance(o.Substance)
+	if err != nil {
+		return 0, true, err
+	}
+
+	target, ok := substanceTarget(unitType, toUnit)
+	if !ok {
+		return 0, false, nil
+	}
+
+	from, err := resolve(unitType, fromUnit)
+	if err != nil {
+		return 0, true, err
+	}
+
+	to, err := resolve(target, toUnit)
+	if err != nil {
+		return 0, true, err
+	}
+
+	if err := checkDomain(unitType, fromUnit, from, value, o.Signed); err != nil {
+		return 0, true, err
+	}
+
+	// Volumes are based on cubic meters and weights on grams
+	density := substance.Density * millilitersPerCubicMeter
+	base := from.ToBase(value)
+	if unitType == Volume {
+		base *= density
+	} else {
+		base /= density
+	}
+
+	return o.Round(to.FromBase(base)), true, nil
+}
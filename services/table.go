@@ -0,0 +1,300 @@
+package services
+
+import (
+	"embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// Table lookup failures, usable with errors.Is
+var (
+	ErrOutsideTable   = errors.New("value outside the table")
+	ErrBetweenEntries = errors.New("value between table entries")
+)
+
+// TableLookup defines how a table-backed unit reads values that fall between two of its entries
+type TableLookup int
+
+// Supported table lookups
+const (
+	InterpolatedLookup TableLookup = iota // linearly between the two entries
+	NearestLookup                         // as the closest entry
+	ExactLookup                           // not at all: only listed values convert
+)
+
+// WithTableLookup selects how table-backed units read values between their entries
+func WithTableLookup(lookup TableLookup) ConvertOption {
+	return func(o *ConvertOptions) {
+		o.TableLookup = lookup
+	}
+}
+
+// Table lists the sizes of a table-backed unit against the values of a
+// reference unit, such as gas marks against degrees Fahrenheit. Both Sizes
+// and References are strictly increasing.
+type Table struct {
+	Sizes      []float64
+	References []float64
+}
+
+// Tabulated defines a unit read from table, whose reference values are in the
+// unit that reference defines, as gas marks are read against degrees Fahrenheit
+func Tabulated(table *Table, reference UnitDefinition) UnitDefinition {
+	reference.Table = table
+	reference.exact = nil
+	return reference
+}
+
+// linear returns the definition of the reference unit of a table-backed unit
+func (d UnitDefinition) linear() UnitDefinition {
+	d.Table = nil
+	return d
+}
+
+//go:embed tables/*.csv
+var tableFiles embed.FS
+
+// Tables read from the embedded files at init
+var (
+	shoeSizes = mustReadTables("tables/shoe-sizes.csv")
+	ringSizes = mustReadTables("tables/ring-sizes.csv")
+	gasMarks  = mustReadTables("tables/gas-marks.csv")
+)
+
+// mustReadTables reads an embedded table file. It panics on malformed files,
+// since tables are fixed at init.
+func mustReadTables(name string) map[Unit]*Table {
+	file, err := tableFiles.Open(name)
+	if err != nil {
+		panic(fmt.Sprintf("services: %v", err))
+	}
+	defer file.Close()
+
+	tables, err := ReadTables(file)
+	if err != nil {
+		panic(fmt.Sprintf("services: %s: %v", name, err))
+	}
+	return tables
+}
+
+// ReadTables reads a CSV file of tables. The header names the reference unit
+// and then one unit per column; each row gives a reference value and the sizes
+// matching it, left empty when a unit has none. Sizes may be fractions ("1/4")
+// and lines starting with "#" are comments.
+func ReadTables(r io.Reader) (map[Unit]*Table, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 2 || len(records[0]) < 2 {
+		return nil, errors.New("a table needs a header with a reference and a unit, and at least one row")
+	}
+
+	header := records[0]
+	tables := make(map[Unit]*Table, len(header)-1)
+	for _, record := range records[1:] {
+		reference, err := parseTableValue(record[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for column, cell := range record[1:] {
+			if strings.TrimSpace(cell) == "" {
+				continue
+			}
+
+			size, err := parseTableValue(cell)
+			if err != nil {
+				return nil, err
+			}
+
+			unit := Unit(strings.TrimSpace(header[column+1]))
+			if tables[unit] == nil {
+				tables[unit] = &Table{}
+			}
+			tables[unit].Sizes = append(tables[unit].Sizes, size)
+			tables[unit].References = append(tables[unit].References, reference)
+		}
+	}
+
+	for unit, table := range tables {
+		if err := table.check(); err != nil {
+			return nil, fmt.Errorf("%s: %w", unit, err)
+		}
+	}
+
+	return tables, nil
+}
+
+func parseTableValue(s string) (float64, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return 0, fmt.Errorf("invalid table value %q", s)
+	}
+
+	value, _ := rat.Float64()
+	return value, nil
+}
+
+// check makes sure the sizes and references of the table both increase, so
+// that each can be looked up from the other
+func (t *Table) check() error {
+	if len(t.Sizes) == 0 || len(t.Sizes) != len(t.References) {
+		return errors.New("a table needs as many sizes as references, and at least one")
+	}
+
+	for i := 1; i < len(t.Sizes); i++ {
+		if !(t.Sizes[i] > t.Sizes[i-1]) || !(t.References[i] > t.References[i-1]) {
+			return fmt.Errorf("size %v for %v does not follow size %v for %v in increasing order",
+				t.Sizes[i], t.References[i], t.Sizes[i-1], t.References[i-1])
+		}
+	}
+
+	return nil
+}
+
+// tableTolerance is how far, relative to an entry, a value may stray from it
+// and still match it, so that sizes survive the float64 round trip through the base unit
+const tableTolerance = 1e-9
+
+// lookupEntry reads the value matching x in the parallel keys and values. i is the
+// index of the entry matching x, or of the entry above it when it is between
+// two entries. err is ErrOutsideTable when x is beyond the keys, and
+// ErrBetweenEntries when it is between two of them and lookup is ExactLookup.
+func lookupEntry(keys, values []float64, x float64, lookup TableLookup) (y float64, i int, between bool, err error) {
+	first, last := keys[0], keys[len(keys)-1]
+	if !(x >= first-tableTolerance*math.Max(1, math.Abs(first))) || !(x <= last+tableTolerance*math.Max(1, math.Abs(last))) {
+		return math.NaN(), 0, false, ErrOutsideTable
+	}
+
+	i = sort.SearchFloat64s(keys, x)
+	for _, candidate := range []int{i - 1, i} {
+		if candidate >= 0 && candidate < len(keys) && math.Abs(x-keys[candidate]) <= tableTolerance*math.Max(1, math.Abs(keys[candidate])) {
+			return values[candidate], candidate, false, nil
+		}
+	}
+
+	// keys[i-1] < x < keys[i]
+	switch lookup {
+	case ExactLookup:
+		return math.NaN(), i, true, ErrBetweenEntries
+	case NearestLookup:
+		if x-keys[i-1] < keys[i]-x {
+			return values[i-1], i, true, nil
+		}
+		return values[i], i, true, nil
+	default:
+		fraction := (x - keys[i-1]) / (keys[i] - keys[i-1])
+		return values[i-1] + fraction*(values[i]-values[i-1]), i, true, nil
+	}
+}
+
+// reference reads the reference value of size, NaN outside the table
+func (t *Table) reference(size float64) float64 {
+	reference, _, _, _ := lookupEntry(t.Sizes, t.References, size, InterpolatedLookup)
+	return reference
+}
+
+// size reads the size of a reference value, NaN outside the table
+func (t *Table) size(reference float64) float64 {
+	size, _, _, _ := lookupEntry(t.References, t.Sizes, reference, InterpolatedLookup)
+	return size
+}
+
+// TableError reports a value that a table-backed unit cannot read.
+// It wraps ErrOutsideTable or ErrBetweenEntries.
+type TableError struct {
+	UnitType UnitType
+	Unit     Unit
+	Value    float64
+	// Target is set when the value converts to a size Target cannot read
+	Target Unit
+	// Low and High are the first and last sizes of the table of a value
+	// outside it, and the sizes on either side of a value between entries
+	Low  float64
+	High float64
+	Err  error
+}
+
+func (e *TableError) Error() string {
+	table := e.Unit
+	if e.Target != "" {
+		table = e.Target
+	}
+
+	if errors.Is(e.Err, ErrBetweenEntries) {
+		return fmt.Sprintf("%s: %s %s falls between the entries %s and %s of %s", e.Err,
+			formatValue(e.Value), e.Unit, formatValue(e.Low), formatValue(e.High), table)
+	}
+
+	return fmt.Sprintf("%s: %s %s is beyond the table of %s, which goes from %s to %s", e.Err,
+		formatValue(e.Value), e.Unit, table, formatValue(e.Low), formatValue(e.High))
+}
+
+func (e *TableError) Unwrap() error {
+	return e.Err
+}
+
+// tableError reports err, found at entry i of the table, as a *TableError
+func (t *Table) tableError(err error, i int, unitType UnitType, unit Unit, value float64, target Unit) error {
+	tableErr := &TableError{UnitType: unitType, Unit: unit, Value: value, Target: target, Err: err}
+	if errors.Is(err, ErrBetweenEntries) {
+		tableErr.Low, tableErr.High = t.Sizes[i-1], t.Sizes[i]
+	} else {
+		tableErr.Low, tableErr.High = t.Sizes[0], t.Sizes[len(t.Sizes)-1]
+	}
+	return tableErr
+}
+
+// convertTable converts value when fromUnit or toUnit is table-backed,
+// reading it between the entries of their tables as the options say. It
+// also reports whether a value fell between two entries.
+func (o ConvertOptions) convertTable(unitType UnitType, fromUnit, toUnit Unit, from, to UnitDefinition, value float64) (float64, bool, error) {
+	base := from.ToBase(value)
+	var fromBetween, toBetween bool
+	if from.Table != nil {
+		reference, i, between, err := lookupEntry(from.Table.Sizes, from.Table.References, value, o.TableLookup)
+		if err != nil {
+			return 0, false, from.Table.tableError(err, i, unitType, fromUnit, value, "")
+		}
+		base, fromBetween = from.linear().ToBase(reference), between
+	}
+
+	if to.Table == nil {
+		return to.FromBase(base), fromBetween, nil
+	}
+
+	size, i, toBetween, err := lookupEntry(to.Table.References, to.Table.Sizes, to.linear().FromBase(base), o.TableLookup)
+	if err != nil {
+		return 0, false, to.Table.tableError(err, i, unitType, fromUnit, value, toUnit)
+	}
+
+	return size, fromBetween || toBetween, nil
+}
+
+// TableResult is a value converted to or from a table-backed unit
+type TableResult struct {
+	Value float64
+	// Between is true when the value, or its conversion, fell between two
+	// entries of a table and was interpolated or taken as the nearest one
+	Between bool
+}
+
+// ConvertTable is Convert for table-backed units, also reporting whether the
+// value fell between the entries of their tables
+func ConvertTable(unitType UnitType, fromUnit, toUnit Unit, value float64, opts ...ConvertOption) (TableResult, error) {
+	converted, between, err := convert(unitType, fromUnit, toUnit, value, newConvertOptions(opts...))
+	if err != nil {
+		return TableResult{}, err
+	}
+
+	return TableResult{Value: converted, Between: between}, nil
+}
@@ -0,0 +1,32 @@
+package services
+
+import "fmt"
+
+// WithInterval reads the value as a difference of temperatures rather than a
+// reading, so the offsets of the scales are not applied: an interval of 10 °C
+// converts to 18 °F, not 50 °F. Intervals may be negative.
+// TemperatureDifference units are intervals already.
+func WithInterval() ConvertOption {
+	return func(o *ConvertOptions) {
+		o.Interval = true
+	}
+}
+
+// interval turns the definitions of a conversion into their interval
+// counterparts when asked to, dropping the offsets of the temperature scales
+func (o ConvertOptions) interval(unitType UnitType, from, to UnitDefinition) (UnitDefinition, UnitDefinition, error) {
+	if !o.Interval || unitType == TemperatureDifference {
+		return from, to, nil
+	}
+
+	if unitType != Temperature {
+		return from, to, fmt.Errorf("%w: intervals only apply to temperatures, not %s", ErrIncompatibleUnits, unitType)
+	}
+
+	if from.Table != nil || to.Table != nil {
+		return from, to, fmt.Errorf("%w: intervals do not apply to table-backed units such as gas marks", ErrIncompatibleUnits)
+	}
+
+	from.Offset, to.Offset = 0, 0
+	return from, to, nil
+}
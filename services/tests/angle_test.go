@@ -0,0 +1,228 @@
+package tests
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAngleConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		opts     []services.ConvertOption
+		expected float64
+		err      error
+	}{
+		{name: "✅ degrees to radians", fromUnit: services.Degrees, toUnit: services.Radians, value: 180, expected: 3.14},
+		{name: "✅ radians to degrees", fromUnit: services.Radians, toUnit: services.Degrees, value: math.Pi / 2, expected: 90},
+		{name: "✅ gradians to degrees", fromUnit: services.Gradians, toUnit: services.Degrees, value: 100, expected: 90},
+		{name: "✅ turns to degrees", fromUnit: services.Turns, toUnit: services.Degrees, value: 1.5, expected: 540},
+		{name: "✅ degrees to arcminutes", fromUnit: services.Degrees, toUnit: services.Arcminutes, value: 1, expected: 60},
+		{name: "✅ arcminutes to arcseconds", fromUnit: services.Arcminutes, toUnit: services.Arcseconds, value: 1, expected: 60},
+		{name: "✅ milliradians to degrees", fromUnit: services.Milliradians, toUnit: services.Degrees, value: 1000, expected: 57.3},
+		{name: "✅ negative angles", fromUnit: services.Degrees, toUnit: services.Turns, value: -90, expected: -0.25},
+		{
+			name:     "✅ positive range",
+			fromUnit: services.Degrees,
+			toUnit:   services.Degrees,
+			value:    -90,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.PositiveAngle)},
+			expected: 270,
+		},
+		{
+			name:     "✅ positive range past a turn",
+			fromUnit: services.Turns,
+			toUnit:   services.Degrees,
+			value:    2.25,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.PositiveAngle)},
+			expected: 90,
+		},
+		{
+			name:     "✅ signed range",
+			fromUnit: services.Degrees,
+			toUnit:   services.Degrees,
+			value:    270,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.SignedAngle)},
+			expected: -90,
+		},
+		{
+			name:     "✅ signed range keeps 180",
+			fromUnit: services.Degrees,
+			toUnit:   services.Degrees,
+			value:    -180,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.SignedAngle)},
+			expected: 180,
+		},
+		{
+			name:     "✅ positive range rounding up to a turn",
+			fromUnit: services.Degrees,
+			toUnit:   services.Degrees,
+			value:    359.999,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.PositiveAngle)},
+			expected: 0,
+		},
+		{
+			name:     "✅ positive range of a tiny negative angle",
+			fromUnit: services.Degrees,
+			toUnit:   services.Degrees,
+			value:    -1e-20,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.PositiveAngle), services.WithoutRounding()},
+			expected: 0,
+		},
+		{
+			name:     "✅ signed range rounding down to -180",
+			fromUnit: services.Degrees,
+			toUnit:   services.Degrees,
+			value:    -179.999,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.SignedAngle)},
+			expected: 180,
+		},
+		{
+			name:     "✅ range in the unit converted to",
+			fromUnit: services.Degrees,
+			toUnit:   services.Gradians,
+			value:    450,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.PositiveAngle)},
+			expected: 100,
+		},
+		{
+			name:     "✅ range in radians",
+			fromUnit: services.Degrees,
+			toUnit:   services.Radians,
+			value:    270,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.SignedAngle)},
+			expected: -1.57,
+		},
+		{
+			name:     "❌ range of a length",
+			fromUnit: services.Meters,
+			toUnit:   services.Feet,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithAngleRange(services.PositiveAngle)},
+			err:      services.ErrIncompatibleUnits,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			unitType := services.Angle
+			if test.fromUnit == services.Meters {
+				unitType = services.Length
+			}
+
+			actual, err := services.Convert(unitType, test.fromUnit, test.toUnit, test.value, test.opts...)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestExactAngleRange(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertDecimal(services.Angle, services.Arcseconds, services.Degrees, "-1", services.WithAngleRange(services.PositiveAngle))
+	asserts.NoError(err)
+	asserts.True(actual.Exact)
+	asserts.Equal(0, actual.Value.Cmp(big.NewRat(1295999, 3600)))
+	asserts.Equal("0.00", actual.Decimal(2))
+	asserts.Equal("359.9997", actual.Decimal(4))
+
+	actual, err = services.ConvertDecimal(services.Angle, services.Degrees, services.Degrees, "-179.999", services.WithAngleRange(services.SignedAngle))
+	asserts.NoError(err)
+	asserts.Equal("180.00", actual.Decimal(2))
+
+	actual, err = services.ConvertDecimal(services.Angle, services.Degrees, services.Radians, "-0.0001", services.WithAngleRange(services.PositiveAngle))
+	asserts.NoError(err)
+	asserts.False(actual.Exact)
+	asserts.Equal("6.28", actual.Decimal(2))
+	asserts.Equal("0.0000", actual.Decimal(4))
+}
+
+func TestParseDMS(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected float64
+		err      error
+	}{
+		{name: "✅ northern latitude", input: `40°26'46"N`, expected: 40 + 26.0/60 + 46.0/3600},
+		{name: "✅ western longitude with primes and spaces", input: `73° 59′ 8.5″ W`, expected: -(73 + 59.0/60 + 8.5/3600)},
+		{name: "✅ letters", input: "33d52m", expected: 33 + 52.0/60},
+		{name: "✅ sign", input: `-12°30'`, expected: -12.5},
+		{name: "✅ degrees only", input: "45°", expected: 45},
+		{name: "✅ decimal degrees", input: "40.5°N", expected: 40.5},
+		{name: "✅ decimal minutes", input: `40°30.5'`, expected: 40 + 30.5/60},
+		{name: "✅ lowercase hemisphere", input: `10°s`, expected: -10},
+		{name: "❌ minutes out of range", input: `10°60'`, err: services.ErrInvalidQuantity},
+		{name: "❌ sign and hemisphere", input: `-10°S`, err: services.ErrInvalidQuantity},
+		{name: "❌ no degrees", input: `26'46"`, err: services.ErrInvalidQuantity},
+		{name: "❌ decimal degrees with minutes", input: `40.5°30'`, err: services.ErrInvalidQuantity},
+		{name: "❌ decimal minutes with seconds", input: `40°30.5'10"`, err: services.ErrInvalidQuantity},
+		{name: "❌ decimal degrees with seconds", input: `40.5°10"`, err: services.ErrInvalidQuantity},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.ParseDMS(test.input)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(services.Degrees, actual.Unit)
+			asserts.InDelta(test.expected, actual.Value, 1e-12, test.name)
+		})
+	}
+}
+
+func TestFormatDMS(t *testing.T) {
+	asserts := assert.New(t)
+
+	dms, err := services.FormatDMS(40.446111, services.Degrees, 0)
+	asserts.NoError(err)
+	asserts.Equal(`40°26'46"`, dms)
+
+	dms, err = services.FormatDMS(-12.5, services.Degrees, 1)
+	asserts.NoError(err)
+	asserts.Equal(`-12°30'0.0"`, dms)
+
+	dms, err = services.FormatDMS(29.9999999, services.Degrees, 0)
+	asserts.NoError(err)
+	asserts.Equal(`30°0'0"`, dms)
+
+	dms, err = services.FormatDMS(math.Pi, services.Radians, 0)
+	asserts.NoError(err)
+	asserts.Equal(`180°0'0"`, dms)
+
+	latitude, err := services.FormatLatitude(40.446111, services.Degrees, 0)
+	asserts.NoError(err)
+	asserts.Equal(`40°26'46"N`, latitude)
+
+	longitude, err := services.FormatLongitude(-73.985694, services.Degrees, 1)
+	asserts.NoError(err)
+	asserts.Equal(`73°59'8.5"W`, longitude)
+
+	_, err = services.FormatLatitude(91, services.Degrees, 0)
+	asserts.ErrorIs(err, services.ErrOutOfDomain)
+
+	// Round trip through the parser
+	parsed, err := services.ParseDMS(longitude)
+	asserts.NoError(err)
+	asserts.InDelta(-73.985694, parsed.Value, 1e-4)
+}
@@ -0,0 +1,244 @@
+package tests
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+const csvRates = `date,currency,rate
+2024-05-02,USD,1.0708
+2024-05-02,GBP,0.8554
+2024-05-03,USD,1.0743
+2024-05-03,JPY,164.59
+`
+
+const jsonRates = `{
+	"base": "USD",
+	"date": "2024-05-03",
+	"rates": {"EUR": 0.9308, "GBP": 0.7962},
+	"history": [{"date": "2024-05-02", "rates": {"EUR": 0.9339}}]
+}`
+
+const xmlRates = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2024-05-03">
+			<Cube currency="USD" rate="1.0743"/>
+			<Cube currency="CHF" rate="0.9744"/>
+		</Cube>
+		<Cube time="2024-05-02">
+			<Cube currency="USD" rate="1.0708"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func useRates(t *testing.T, rates string, format services.RateFormat) {
+	t.Helper()
+
+	table, err := services.ReadRates(strings.NewReader(rates), format)
+	assert.NoError(t, err)
+
+	services.UseRates(table)
+	t.Cleanup(func() { services.UseRates(nil) })
+}
+
+func date(s string) time.Time {
+	day, err := time.Parse(services.RateDateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return day
+}
+
+func TestCurrencyConverter(t *testing.T) {
+	asserts := assert.New(t)
+	useRates(t, csvRates, services.RatesCSV)
+
+	tests := []struct {
+		name     string
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		opts     []services.ConvertOption
+		expected float64
+		err      error
+	}{
+		{name: "✅ base to currency", fromUnit: "EUR", toUnit: "USD", value: 100, expected: 107.43},
+		{name: "✅ currency to base", fromUnit: "USD", toUnit: "EUR", value: 107.43, expected: 100},
+		{name: "✅ across currencies", fromUnit: "GBP", toUnit: "JPY", value: 1, expected: 192.41},
+		{name: "✅ lowercase codes", fromUnit: "usd", toUnit: "eur", value: 10.743, expected: 10},
+		{name: "✅ debts", fromUnit: "EUR", toUnit: "USD", value: -100, opts: []services.ConvertOption{services.WithSigned()}, expected: -107.43},
+		{
+			name:     "✅ past rate",
+			fromUnit: "EUR",
+			toUnit:   "USD",
+			value:    100,
+			opts:     []services.ConvertOption{services.WithRateDate(date("2024-05-02"))},
+			expected: 107.08,
+		},
+		{
+			name:     "✅ rate still in effect after its date",
+			fromUnit: "EUR",
+			toUnit:   "GBP",
+			value:    100,
+			opts:     []services.ConvertOption{services.WithRateDate(date("2024-06-30"))},
+			expected: 85.54,
+		},
+		{
+			name:     "❌ before the first rate",
+			fromUnit: "EUR",
+			toUnit:   "JPY",
+			value:    1,
+			opts:     []services.ConvertOption{services.WithRateDate(date("2024-05-02"))},
+			err:      services.ErrNoRate,
+		},
+		{name: "❌ unknown currency", fromUnit: "EUR", toUnit: "CAD", value: 1, err: services.ErrUnknownUnit},
+		{name: "❌ negative amount", fromUnit: "EUR", toUnit: "USD", value: -1, err: services.ErrOutOfDomain},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(services.Currency, test.fromUnit, test.toUnit, test.value, test.opts...)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestConvertCurrencyReportsRateDate(t *testing.T) {
+	asserts := assert.New(t)
+	useRates(t, csvRates, services.RatesCSV)
+
+	actual, err := services.ConvertCurrency("EUR", "USD", 100)
+	asserts.NoError(err)
+	asserts.Equal(107.43, actual.Value)
+	asserts.Equal(date("2024-05-03"), actual.Date)
+
+	// GBP was last quoted the day before
+	actual, err = services.ConvertCurrency("GBP", "USD", 100)
+	asserts.NoError(err)
+	asserts.Equal(date("2024-05-02"), actual.Date)
+
+	actual, err = services.ConvertCurrency("USD", "EUR", 100, services.WithRateDate(date("2024-05-02")))
+	asserts.NoError(err)
+	asserts.Equal(93.39, actual.Value)
+	asserts.Equal(date("2024-05-02"), actual.Date)
+
+	actual, err = services.ConvertCurrency("EUR", "EUR", 100)
+	asserts.NoError(err)
+	asserts.True(actual.Date.IsZero())
+}
+
+func TestConvertCurrencyReadsOneRateTable(t *testing.T) {
+	asserts := assert.New(t)
+
+	tables := make([]*services.RateTable, 2)
+	for i, rates := range []string{"date,currency,rate\n2024-05-02,USD,2\n", "date,currency,rate\n2024-05-03,USD,4\n"} {
+		table, err := services.ReadRates(strings.NewReader(rates), services.RatesCSV)
+		asserts.NoError(err)
+		tables[i] = table
+	}
+	services.UseRates(tables[0])
+	t.Cleanup(func() { services.UseRates(nil) })
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			services.UseRates(tables[i%2])
+		}
+	}()
+
+	// Every result pairs a rate with its own date, whichever file is in use
+	expected := map[float64]time.Time{2: date("2024-05-02"), 4: date("2024-05-03")}
+	for i := 0; i < 1000; i++ {
+		actual, err := services.ConvertCurrency("EUR", "USD", 1)
+		asserts.NoError(err)
+		asserts.Equal(expected[actual.Value], actual.Date, "%v USD", actual.Value)
+	}
+	<-done
+}
+
+func TestRateFileFormats(t *testing.T) {
+	asserts := assert.New(t)
+
+	useRates(t, jsonRates, services.RatesJSON)
+	actual, err := services.ConvertCurrency("EUR", "USD", 93.39, services.WithRateDate(date("2024-05-02")))
+	asserts.NoError(err)
+	asserts.Equal(100.0, actual.Value)
+	asserts.Equal(services.Unit("usd"), services.CurrentRates().Base)
+
+	useRates(t, xmlRates, services.RatesXML)
+	actual, err = services.ConvertCurrency("CHF", "EUR", 0.9744)
+	asserts.NoError(err)
+	asserts.Equal(1.0, actual.Value)
+	asserts.Equal(date("2024-05-03"), actual.Date)
+
+	exact, err := services.ConvertDecimal(services.Currency, "EUR", "USD", "2", services.WithRateDate(date("2024-05-02")))
+	asserts.NoError(err)
+	asserts.True(exact.Exact)
+	asserts.Equal(0, exact.Value.Cmp(big.NewRat(21416, 10000)))
+}
+
+func TestInvalidRateFiles(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name   string
+		rates  string
+		format services.RateFormat
+	}{
+		{name: "❌ missing column", rates: "date,currency\n2024-05-02,USD\n", format: services.RatesCSV},
+		{name: "❌ invalid date", rates: "date,currency,rate\n02/05/2024,USD,1.07\n", format: services.RatesCSV},
+		{name: "❌ invalid rate", rates: "date,currency,rate\n2024-05-02,USD,abc\n", format: services.RatesCSV},
+		{name: "❌ zero rate", rates: "date,currency,rate\n2024-05-02,USD,0\n", format: services.RatesCSV},
+		{name: "❌ invalid currency code", rates: "date,currency,rate\n2024-05-02,US Dollar,1.07\n", format: services.RatesCSV},
+		{name: "❌ mixed bases", rates: "date,base,currency,rate\n2024-05-02,EUR,USD,1.07\n2024-05-02,USD,GBP,0.79\n", format: services.RatesCSV},
+		{name: "❌ unsupported format", rates: csvRates, format: "yaml"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := services.ReadRates(strings.NewReader(test.rates), test.format)
+			asserts.Error(err)
+		})
+	}
+}
+
+func TestLoadRatesKeepsRatesInUseOnFailure(t *testing.T) {
+	asserts := assert.New(t)
+	t.Cleanup(func() { services.UseRates(nil) })
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rates.csv")
+	asserts.NoError(os.WriteFile(path, []byte(csvRates), 0o644))
+	asserts.NoError(services.LoadRates(path))
+
+	asserts.NoError(os.WriteFile(path, []byte("date,currency,rate\nbroken\n"), 0o644))
+	asserts.Error(services.LoadRates(path))
+
+	actual, err := services.Convert(services.Currency, "EUR", "USD", 100)
+	asserts.NoError(err)
+	asserts.Equal(107.43, actual)
+}
+
+func TestCurrenciesWithoutRates(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.Currency, "EUR", "USD", 1)
+	asserts.ErrorIs(err, services.ErrUnknownUnit)
+	asserts.Empty(services.Units(services.Currency))
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		unitType services.UnitType
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		expected float64
+		err      error
+	}{
+		{name: "✅ bytes to bits", unitType: services.Data, fromUnit: services.Bytes, toUnit: services.Bits, value: 1, expected: 8},
+		{name: "✅ kilobytes are decimal", unitType: services.Data, fromUnit: services.Kilobytes, toUnit: services.Bytes, value: 1, expected: 1000},
+		{name: "✅ kibibytes are binary", unitType: services.Data, fromUnit: services.Kibibytes, toUnit: services.Bytes, value: 1, expected: 1024},
+		{name: "✅ a 1 TB disk in TiB", unitType: services.Data, fromUnit: services.Terabytes, toUnit: services.Tebibytes, value: 1, expected: 0.91},
+		{name: "✅ gibibytes to megabytes", unitType: services.Data, fromUnit: services.Gibibytes, toUnit: services.Megabytes, value: 1, expected: 1073.74},
+		{name: "✅ petabytes to pebibytes", unitType: services.Data, fromUnit: services.Petabytes, toUnit: services.Pebibytes, value: 1, expected: 0.89},
+		{name: "✅ gigabits to megabytes", unitType: services.Data, fromUnit: services.Gigabits, toUnit: services.Megabytes, value: 1, expected: 125},
+		{name: "✅ symbols", unitType: services.Data, fromUnit: "MiB", toUnit: "kB", value: 1, expected: 1048.58},
+		{name: "✅ megabits per second to megabytes per second", unitType: services.DataRate, fromUnit: services.MegabitsPerSecond, toUnit: services.MegabytesPerSecond, value: 100, expected: 12.5},
+		{name: "✅ gigabits per second to mebibytes per second", unitType: services.DataRate, fromUnit: services.GigabitsPerSecond, toUnit: services.MebibytesPerSecond, value: 1, expected: 119.21},
+		{name: "✅ bps symbols", unitType: services.DataRate, fromUnit: "Mbps", toUnit: "kbit/s", value: 2.5, expected: 2500},
+		{name: "❌ KB could be either", unitType: services.Data, fromUnit: "KB", toUnit: services.Bytes, value: 1, err: services.ErrAmbiguousUnit},
+		{name: "❌ no millibytes", unitType: services.Data, fromUnit: "millibytes", toUnit: services.Bytes, value: 1, err: services.ErrUnknownUnit},
+		{name: "❌ data is not a rate", unitType: services.DataRate, fromUnit: services.Megabytes, toUnit: services.MegabytesPerSecond, value: 1, err: services.ErrIncompatibleUnits},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestDataLookup(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected services.UnitRef
+	}{
+		{input: "kB", expected: services.UnitRef{Type: services.Data, Unit: services.Kilobytes}},
+		{input: "KiB", expected: services.UnitRef{Type: services.Data, Unit: services.Kibibytes}},
+		{input: "MB", expected: services.UnitRef{Type: services.Data, Unit: services.Megabytes}},
+		{input: "Mb", expected: services.UnitRef{Type: services.Data, Unit: services.Megabits}},
+		{input: "Gbit", expected: services.UnitRef{Type: services.Data, Unit: services.Gigabits}},
+		{input: "MB/s", expected: services.UnitRef{Type: services.DataRate, Unit: services.MegabytesPerSecond}},
+		{input: "MBps", expected: services.UnitRef{Type: services.DataRate, Unit: services.MegabytesPerSecond}},
+		{input: "Gbps", expected: services.UnitRef{Type: services.DataRate, Unit: services.GigabitsPerSecond}},
+	}
+
+	for _, test := range tests {
+		t.Run("✅ "+test.input, func(t *testing.T) {
+			actual, err := services.Lookup(test.input)
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual)
+		})
+	}
+
+	_, err := services.Lookup("KB")
+
+	var ambiguous *services.AmbiguousUnitError
+	if asserts.True(errors.As(err, &ambiguous)) {
+		asserts.Equal([]services.UnitRef{
+			{Type: services.Data, Unit: services.Kibibytes},
+			{Type: services.Data, Unit: services.Kilobytes},
+		}, ambiguous.Candidates)
+	}
+}
+
+func TestDataRateFromCompoundUnits(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertUnits(1, "GB/h", "Mbps")
+	asserts.NoError(err)
+	asserts.Equal(2.22, actual)
+
+	_, err = services.ConvertUnits(1, "MB", "m")
+	asserts.EqualError(err, `incompatible units: "MB" is D, "m" is L`)
+}
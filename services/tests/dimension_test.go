@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnit(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name      string
+		input     string
+		dimension services.Dimension
+		factor    float64
+		err       error
+	}{
+		{
+			name:      "✅ speed",
+			input:     "km/h",
+			dimension: services.Dimension{services.DimLength: 1, services.DimTime: -1},
+			factor:    1000.0 / 3600,
+		},
+		{
+			name:      "✅ acceleration with superscript",
+			input:     "m/s²",
+			dimension: services.Dimension{services.DimLength: 1, services.DimTime: -2},
+			factor:    1,
+		},
+		{
+			name:      "✅ force from base units",
+			input:     "kg·m/s^2",
+			dimension: services.Dimensions[services.Force],
+			factor:    1,
+		},
+		{
+			name:      "✅ torque",
+			input:     "lbf·ft",
+			dimension: services.Dimensions[services.Energy],
+			factor:    4.4482216152605 * 0.3048,
+		},
+		{
+			name:      "✅ negative exponent",
+			input:     "m s⁻¹",
+			dimension: services.Dimension{services.DimLength: 1, services.DimTime: -1},
+			factor:    1,
+		},
+		{
+			name:      "✅ grams are scaled to kilograms",
+			input:     "g",
+			dimension: services.Dimensions[services.Weight],
+			factor:    0.001,
+		},
+		{
+			name:  "❌ unknown factor",
+			input: "km/fortnight",
+			err:   services.ErrUnknownUnit,
+		},
+		{
+			name:  "❌ affine unit in a compound",
+			input: "°C/s",
+			err:   services.ErrIncompatibleUnits,
+		},
+		{
+			name:  "❌ invalid exponent",
+			input: "m^x",
+			err:   services.ErrInvalidQuantity,
+		},
+		{
+			name:  "❌ empty",
+			input: " ",
+			err:   services.ErrInvalidQuantity,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.ParseUnit(test.input)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.dimension, actual.Dimension, test.name)
+			asserts.InEpsilon(test.factor, actual.Factor, 1e-15, test.name)
+		})
+	}
+}
+
+func TestConvertUnits(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		value    float64
+		from     string
+		to       string
+		opts     []services.ConvertOption
+		expected float64
+		err      error
+	}{
+		{name: "✅ km/h to m/s", value: 36, from: "km/h", to: "m/s", expected: 10},
+		{name: "✅ mi/h to km/h", value: 60, from: "mi/h", to: "km/h", expected: 96.56},
+		{name: "✅ kWh to joules", value: 1, from: "kWh", to: "J", expected: 3600000},
+		{name: "✅ newton metres to joules", value: 5, from: "N·m", to: "J", expected: 5},
+		{name: "✅ pound-force feet to newton metres", value: 100, from: "lbf·ft", to: "N·m", expected: 135.58},
+		{name: "✅ base units to newtons", value: 2, from: "kg·m/s²", to: "N", expected: 2},
+		{name: "✅ knots to km/h", value: 10, from: "kn", to: "km/h", expected: 18.52},
+		{name: "✅ mph to compound m/s", value: 100, from: "mph", to: "m/s", expected: 44.7},
+		{name: "✅ plain affine units", value: 100, from: "°C", to: "°F", expected: 212},
+		{name: "✅ affine unit to a compound", value: 0, from: "°C", to: "K·m/m", expected: 273.15},
+		{name: "✅ compound to an affine unit", value: 273.15, from: "K·m/m", to: "°F", expected: 32},
+		{name: "✅ temperature differences in compounds", value: 10, from: "Δ°F", to: "ΔK·m/m", expected: 5.56},
+		{name: "❌ temperature to a temperature difference", value: 20, from: "°C", to: "ΔK", err: services.ErrIncompatibleUnits},
+		{name: "❌ temperature difference to a temperature", value: 10, from: "Δ°C", to: "°F", err: services.ErrIncompatibleUnits},
+		{name: "❌ kelvin to a compound temperature difference", value: 10, from: "K", to: "Δ°F·m/m", err: services.ErrIncompatibleUnits},
+		{name: "✅ negative velocity", value: -10, from: "m/s", to: "km·h^-1", expected: -36},
+		{name: "✅ signed compound", value: -1, from: "kg·m", to: "lb·ft", opts: []services.ConvertOption{services.WithSigned()}, expected: -7.23},
+		{name: "❌ affine unit below absolute zero", value: -300, from: "°C", to: "K·m/m", err: services.ErrOutOfDomain},
+		{name: "❌ negative compound", value: -1, from: "kg·m", to: "lb·ft", err: services.ErrOutOfDomain},
+		{name: "❌ negative compound in a denominator", value: -1, from: "J/K", to: "J·K^-1", opts: []services.ConvertOption{services.WithSigned()}, err: services.ErrOutOfDomain},
+		{name: "❌ different dimensions", value: 1, from: "km/h", to: "kg", err: services.ErrIncompatibleUnits},
+		{name: "❌ energy is not power", value: 1, from: "kWh", to: "N", err: services.ErrIncompatibleUnits},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.ConvertUnits(test.value, test.from, test.to, test.opts...)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestDimensionString(t *testing.T) {
+	asserts := assert.New(t)
+
+	asserts.Equal("L·M·T⁻²", services.Dimensions[services.Force].String())
+	asserts.Equal("1", services.Dimension{}.String())
+
+	_, err := services.ConvertUnits(1, "km/h", "kg")
+	asserts.EqualError(err, `incompatible units: "km/h" is L·T⁻¹, "kg" is M`)
+}
+
+func TestEveryUnitTypeHasADimension(t *testing.T) {
+	asserts := assert.New(t)
+
+	for unitType := range services.Registry {
+		_, ok := services.Dimensions[unitType]
+		asserts.True(ok, "missing dimension for %s", unitType)
+	}
+}
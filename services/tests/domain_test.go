@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertDomain(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name      string
+		unitType  services.UnitType
+		fromUnit  services.Unit
+		toUnit    services.Unit
+		value     float64
+		opts      []services.ConvertOption
+		expected  float64
+		expectErr bool
+	}{
+		{
+			name:      "❌ negative kelvin",
+			unitType:  services.Temperature,
+			fromUnit:  services.Kelvin,
+			toUnit:    services.Celsius,
+			value:     -50,
+			expectErr: true,
+		},
+		{
+			name:      "❌ below absolute zero in celsius",
+			unitType:  services.Temperature,
+			fromUnit:  services.Celsius,
+			toUnit:    services.Kelvin,
+			value:     -273.16,
+			expectErr: true,
+		},
+		{
+			name:     "✅ absolute zero in fahrenheit",
+			unitType: services.Temperature,
+			fromUnit: services.Fahrenheit,
+			toUnit:   services.Kelvin,
+			value:    -459.67,
+			expected: 0,
+		},
+		{
+			name:     "✅ negative celsius above absolute zero",
+			unitType: services.Temperature,
+			fromUnit: services.Celsius,
+			toUnit:   services.Fahrenheit,
+			value:    -40,
+			expected: -40,
+		},
+		{
+			name:      "❌ signed does not apply to absolute temperatures",
+			unitType:  services.Temperature,
+			fromUnit:  services.Kelvin,
+			toUnit:    services.Celsius,
+			value:     -1,
+			opts:      []services.ConvertOption{services.WithSigned()},
+			expectErr: true,
+		},
+		{
+			name:      "❌ negative length",
+			unitType:  services.Length,
+			fromUnit:  services.Meters,
+			toUnit:    services.Feet,
+			value:     -1,
+			expectErr: true,
+		},
+		{
+			name:     "✅ negative length as a signed displacement",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   services.Feet,
+			value:    -1,
+			opts:     []services.ConvertOption{services.WithSigned()},
+			expected: -3.28,
+		},
+		{
+			name:      "❌ negative weight",
+			unitType:  services.Weight,
+			fromUnit:  services.Grams,
+			toUnit:    services.Ounces,
+			value:     -1,
+			expectErr: true,
+		},
+		{
+			name:      "❌ NaN",
+			unitType:  services.Weight,
+			fromUnit:  services.Grams,
+			toUnit:    services.Ounces,
+			value:     math.NaN(),
+			expectErr: true,
+		},
+		{
+			name:      "❌ infinity even when signed",
+			unitType:  services.Length,
+			fromUnit:  services.Meters,
+			toUnit:    services.Feet,
+			value:     math.Inf(-1),
+			opts:      []services.ConvertOption{services.WithSigned()},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value, test.opts...)
+			if test.expectErr {
+				asserts.ErrorIs(err, services.ErrOutOfDomain)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestDomainErrorMessage(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.Temperature, services.Celsius, services.Kelvin, -300)
+	asserts.EqualError(err, "value out of domain: -300 celsius is below the minimum of -273.15 celsius for temperature")
+
+	var domainErr *services.DomainError
+	if asserts.True(errors.As(err, &domainErr)) {
+		asserts.Equal(services.Celsius, domainErr.Unit)
+		asserts.Equal(-273.15, domainErr.Min)
+	}
+
+	_, err = services.Convert(services.Length, services.Meters, services.Feet, math.Inf(1))
+	asserts.EqualError(err, "value out of domain: +Inf is not a finite number")
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected float64
+		err      error
+	}{
+		{name: "✅ iso days, hours and minutes", input: "P1DT2H30M", expected: 95400},
+		{name: "✅ iso fractional seconds", input: "PT0.5S", expected: 0.5},
+		{name: "✅ iso decimal comma", input: "PT1,5H", expected: 5400},
+		{name: "✅ iso weeks", input: "P2W", expected: 1209600},
+		{name: "✅ iso negative", input: "-P1D", expected: -86400},
+		{name: "✅ iso average year", input: "P1Y", expected: 31556952},
+		{name: "✅ iso average month", input: "P1M", expected: 2629746},
+		{name: "✅ iso minutes are not months", input: "PT1M", expected: 60},
+		{name: "✅ go duration", input: "1h30m", expected: 5400},
+		{name: "✅ go milliseconds", input: "300ms", expected: 0.3},
+		{name: "✅ go negative", input: "-1.5h", expected: -5400},
+		{name: "❌ iso without components", input: "P", err: services.ErrInvalidQuantity},
+		{name: "❌ iso time designator without components", input: "P1DT", err: services.ErrInvalidQuantity},
+		{name: "❌ iso components out of order", input: "PT1S1M", err: services.ErrInvalidQuantity},
+		{name: "❌ iso invalid number", input: "P1.2.3D", err: services.ErrInvalidQuantity},
+		{name: "❌ neither format", input: "an hour", err: services.ErrInvalidQuantity},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.ParseDuration(test.input)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(services.Quantity{Value: test.expected, Unit: services.Seconds, Type: services.Time}, actual, test.name)
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		value    float64
+		unit     services.Unit
+		iso      string
+		goString string
+		err      error
+		goErr    error
+	}{
+		{name: "✅ days, hours and minutes", value: 95400, unit: services.Seconds, iso: "P1DT2H30M", goString: "26h30m0s"},
+		{name: "✅ whole days", value: 2, unit: services.Days, iso: "P2D", goString: "48h0m0s"},
+		{name: "✅ fractional hours", value: 1.1, unit: services.Hours, iso: "PT1H6M", goString: "1h6m0s"},
+		{name: "✅ milliseconds", value: 1500, unit: services.Milliseconds, iso: "PT1.5S", goString: "1.5s"},
+		{name: "✅ zero", value: 0, unit: services.Minutes, iso: "PT0S", goString: "0s"},
+		{name: "✅ negative", value: -90, unit: services.Minutes, iso: "-PT1H30M", goString: "-1h30m0s"},
+		{name: "✅ longer than a Go duration", value: 400, unit: services.GregorianYears, iso: "P146097D", goErr: services.ErrOutOfDomain},
+		{name: "✅ more seconds than an int64", value: 1e12, unit: services.JulianYears, iso: "P365250000000000D", goErr: services.ErrOutOfDomain},
+		{name: "❌ not a time unit", value: 1, unit: services.Meters, err: services.ErrIncompatibleUnits},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			iso, err := services.FormatISODuration(test.value, test.unit)
+			goString, goErr := services.FormatGoDuration(test.value, test.unit)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				asserts.ErrorIs(goErr, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.iso, iso, test.name)
+			if test.goErr != nil {
+				asserts.ErrorIs(goErr, test.goErr)
+				return
+			}
+
+			asserts.NoError(goErr)
+			asserts.Equal(test.goString, goString, test.name)
+		})
+	}
+}
+
+func TestCalendarUnitsAreApproximate(t *testing.T) {
+	asserts := assert.New(t)
+
+	for _, unit := range []services.Unit{services.GregorianMonths, services.JulianMonths, services.GregorianYears, services.JulianYears} {
+		definition, ok := services.Definition(services.Time, unit)
+		asserts.True(ok)
+		asserts.True(definition.Approximate, "%s should be marked as approximate", unit)
+	}
+
+	for _, unit := range []services.Unit{services.Milliseconds, services.Days, services.Weeks} {
+		definition, ok := services.Definition(services.Time, unit)
+		asserts.True(ok)
+		asserts.False(definition.Approximate, "%s should be exact", unit)
+	}
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnergyConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		expected float64
+	}{
+		{name: "✅ kilojoules to joules", fromUnit: services.Kilojoules, toUnit: services.Joules, value: 1.5, expected: 1500},
+		{name: "✅ megajoules to kilowatt-hours", fromUnit: services.Megajoules, toUnit: services.KilowattHours, value: 3.6, expected: 1},
+		{name: "✅ kilocalories to kilojoules", fromUnit: services.Kilocalories, toUnit: services.Kilojoules, value: 250, expected: 1046},
+		{name: "✅ food calories are kilocalories", fromUnit: "Cal", toUnit: "cal", value: 1, expected: 1000},
+		{name: "✅ btu to joules", fromUnit: services.BritishThermalUnits, toUnit: services.Joules, value: 1, expected: 1055.06},
+		{name: "✅ therms to joules", fromUnit: services.Therms, toUnit: services.Joules, value: 1, expected: 105480400},
+		{name: "✅ therms to kilowatt-hours", fromUnit: services.Therms, toUnit: services.KilowattHours, value: 1, expected: 29.3},
+		{name: "✅ electronvolts to joules", fromUnit: services.Electronvolts, toUnit: services.Joules, value: 1e19, expected: 1.6},
+		{name: "✅ megaelectronvolts to kiloelectronvolts", fromUnit: "MeV", toUnit: "keV", value: 1, expected: 1000},
+		{name: "✅ foot-pounds-force to joules", fromUnit: services.FootPoundsForce, toUnit: services.Joules, value: 100, expected: 135.58},
+		{name: "✅ foot-pounds-force alternate symbol", fromUnit: "ft-lbf", toUnit: "J", value: 1, expected: 1.36},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(services.Energy, test.fromUnit, test.toUnit, test.value)
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestPowerConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		expected float64
+		err      error
+	}{
+		{name: "✅ kilowatts to watts", fromUnit: services.Kilowatts, toUnit: services.Watts, value: 2.2, expected: 2200},
+		{name: "✅ megawatts to kilowatts", fromUnit: services.Megawatts, toUnit: services.Kilowatts, value: 1, expected: 1000},
+		{name: "✅ horsepower to watts", fromUnit: services.Horsepower, toUnit: services.Watts, value: 1, expected: 745.7},
+		{name: "✅ metric horsepower to watts", fromUnit: services.MetricHorsepower, toUnit: services.Watts, value: 1, expected: 735.5},
+		{name: "✅ kilowatts to horsepower", fromUnit: services.Kilowatts, toUnit: services.Horsepower, value: 100, expected: 134.1},
+		{name: "✅ btu per hour to watts", fromUnit: services.BTUsPerHour, toUnit: services.Watts, value: 12000, expected: 3516.85},
+		{name: "✅ tons of refrigeration to btu per hour", fromUnit: services.TonsOfRefrigeration, toUnit: services.BTUsPerHour, value: 2, expected: 24000},
+		{name: "✅ symbols", fromUnit: "hp", toUnit: "PS", value: 100, expected: 101.39},
+		{name: "❌ energy is not power", fromUnit: services.KilowattHours, toUnit: services.Kilowatts, value: 1, err: services.ErrIncompatibleUnits},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(services.Power, test.fromUnit, test.toUnit, test.value)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestPowerFromCompoundUnits(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertUnits(1, "kWh/h", "kW")
+	asserts.NoError(err)
+	asserts.Equal(1.0, actual)
+
+	actual, err = services.ConvertUnits(550, "ft·lbf/s", "hp")
+	asserts.NoError(err)
+	asserts.Equal(1.0, actual)
+}
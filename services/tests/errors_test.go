@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertErrors(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		unitType services.UnitType
+		fromUnit services.Unit
+		toUnit   services.Unit
+		expected error
+		unit     services.Unit
+		actual   services.UnitType
+	}{
+		{
+			name:     "❌ unknown unit type",
+			unitType: "invalid",
+			fromUnit: services.Meters,
+			toUnit:   services.Feet,
+			expected: services.ErrUnknownUnitType,
+		},
+		{
+			name:     "❌ unknown from unit",
+			unitType: services.Length,
+			fromUnit: "invalid",
+			toUnit:   services.Feet,
+			expected: services.ErrUnknownUnit,
+			unit:     "invalid",
+		},
+		{
+			name:     "❌ unknown to unit",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   "invalid",
+			expected: services.ErrUnknownUnit,
+			unit:     "invalid",
+		},
+		{
+			name:     "❌ units of different types",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   services.Grams,
+			expected: services.ErrIncompatibleUnits,
+			unit:     services.Grams,
+			actual:   services.Weight,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, 1)
+			asserts.ErrorIs(err, test.expected)
+
+			var unitErr *services.UnitError
+			if asserts.True(errors.As(err, &unitErr)) {
+				asserts.Equal(test.unitType, unitErr.UnitType)
+				asserts.Equal(test.unit, unitErr.Unit)
+				asserts.Equal(test.actual, unitErr.Actual)
+			}
+		})
+	}
+}
+
+func TestUnitErrorMessages(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.Length, services.Meters, services.Grams, 1)
+	asserts.EqualError(err, `incompatible units: "grams" is a weight unit, not length`)
+
+	_, err = services.Convert(services.Length, "parsecs", services.Meters, 1)
+	asserts.EqualError(err, `unknown unit "parsecs" for length`)
+
+	_, err = services.Convert("luminosity", services.Meters, services.Feet, 1)
+	asserts.EqualError(err, `unknown unit type "luminosity"`)
+}
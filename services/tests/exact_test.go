@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertDecimal(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name      string
+		unitType  services.UnitType
+		fromUnit  services.Unit
+		toUnit    services.Unit
+		value     string
+		expected  string
+		exact     bool
+		expectErr bool
+	}{
+		{
+			name:     "✅ miles to kilometers",
+			unitType: services.Length,
+			fromUnit: services.Miles,
+			toUnit:   services.Kilometers,
+			value:    "1",
+			expected: "1.609344",
+			exact:    true,
+		},
+		{
+			name:     "✅ very large pounds to grams",
+			unitType: services.Weight,
+			fromUnit: services.Pounds,
+			toUnit:   services.Grams,
+			value:    "1e30",
+			expected: "453592370000000000000000000000000",
+			exact:    true,
+		},
+		{
+			name:     "✅ very small milligrams to kilograms",
+			unitType: services.Weight,
+			fromUnit: services.Milligrams,
+			toUnit:   services.Kilograms,
+			value:    "0.000000000000000000001",
+			expected: "1/1000000000000000000000000000",
+			exact:    true,
+		},
+		{
+			name:     "✅ square miles to acres",
+			unitType: services.Area,
+			fromUnit: services.SquareMiles,
+			toUnit:   services.Acres,
+			value:    "1",
+			expected: "640",
+			exact:    true,
+		},
+		{
+			name:     "✅ ounces to pounds",
+			unitType: services.Weight,
+			fromUnit: services.Ounces,
+			toUnit:   services.Pounds,
+			value:    "1",
+			expected: "1/16",
+			exact:    true,
+		},
+		{
+			name:     "✅ feet to yards as a fraction",
+			unitType: services.Length,
+			fromUnit: services.Feet,
+			toUnit:   services.Yards,
+			value:    "1",
+			expected: "1/3",
+			exact:    true,
+		},
+		{
+			name:     "✅ celsius to fahrenheit is inexact",
+			unitType: services.Temperature,
+			fromUnit: services.Celsius,
+			toUnit:   services.Fahrenheit,
+			value:    "100",
+			expected: "212",
+			exact:    false,
+		},
+		{
+			name:     "✅ beyond the float64 range",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   services.Kilometers,
+			value:    "1e400",
+			expected: "1e397",
+			exact:    true,
+		},
+		{
+			name:      "❌ below absolute zero by less than a float64",
+			unitType:  services.Temperature,
+			fromUnit:  services.Kelvin,
+			toUnit:    services.Rankine,
+			value:     "-1e-400",
+			expectErr: true,
+		},
+		{
+			name:      "❌ invalid value",
+			unitType:  services.Length,
+			fromUnit:  services.Miles,
+			toUnit:    services.Kilometers,
+			value:     "one",
+			expectErr: true,
+		},
+		{
+			name:      "❌ invalid unit",
+			unitType:  services.Length,
+			fromUnit:  "invalid",
+			toUnit:    services.Kilometers,
+			value:     "1",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.ConvertDecimal(test.unitType, test.fromUnit, test.toUnit, test.value)
+			asserts.Equal(test.expectErr, err != nil)
+			if err != nil {
+				return
+			}
+
+			expected, _ := new(big.Rat).SetString(test.expected)
+			asserts.Equal(test.exact, actual.Exact, test.name)
+			if test.exact {
+				asserts.Equal(0, expected.Cmp(actual.Value), "expected %s, got %s", expected, actual.Value)
+			} else {
+				asserts.Equal(expected.FloatString(9), actual.Decimal(9), test.name)
+			}
+		})
+	}
+}
+
+func TestConvertRatDoesNotMutateInput(t *testing.T) {
+	asserts := assert.New(t)
+
+	value := big.NewRat(3, 1)
+	result, err := services.ConvertRat(services.Length, services.Feet, services.Yards, value)
+
+	asserts.NoError(err)
+	asserts.Equal("3/1", value.String())
+	asserts.Equal("1.000", result.Decimal(3))
+}
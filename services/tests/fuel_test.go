@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuelEconomyConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		expected float64
+		err      error
+	}{
+		{name: "✅ liters per 100 km to kilometers per liter", fromUnit: services.LitersPer100Kilometers, toUnit: services.KilometersPerLiter, value: 5, expected: 20},
+		{name: "✅ kilometers per liter to liters per 100 km", fromUnit: services.KilometersPerLiter, toUnit: services.LitersPer100Kilometers, value: 12.5, expected: 8},
+		{name: "✅ US mpg to liters per 100 km", fromUnit: services.MilesPerUSGallon, toUnit: services.LitersPer100Kilometers, value: 30, expected: 7.84},
+		{name: "✅ liters per 100 km to US mpg", fromUnit: services.LitersPer100Kilometers, toUnit: services.MilesPerUSGallon, value: 10, expected: 23.52},
+		{name: "✅ liters per 100 km to imperial mpg", fromUnit: services.LitersPer100Kilometers, toUnit: services.MilesPerImperialGallon, value: 10, expected: 28.25},
+		{name: "✅ US mpg to imperial mpg", fromUnit: services.MilesPerUSGallon, toUnit: services.MilesPerImperialGallon, value: 25, expected: 30.02},
+		{name: "✅ US mpg to kilometers per liter", fromUnit: services.MilesPerUSGallon, toUnit: services.KilometersPerLiter, value: 40, expected: 17.01},
+		{name: "✅ symbols", fromUnit: "L/100km", toUnit: "km/L", value: 4, expected: 25},
+		{name: "✅ no distance per volume", fromUnit: services.MilesPerUSGallon, toUnit: services.KilometersPerLiter, value: 0, expected: 0},
+		{name: "❌ no consumption has no distance per volume", fromUnit: services.LitersPer100Kilometers, toUnit: services.MilesPerUSGallon, value: 0, err: services.ErrOutOfDomain},
+		{name: "❌ no distance per volume has no consumption", fromUnit: services.KilometersPerLiter, toUnit: services.LitersPer100Kilometers, value: 0, err: services.ErrOutOfDomain},
+		{name: "❌ negative consumption", fromUnit: services.LitersPer100Kilometers, toUnit: services.KilometersPerLiter, value: -5, err: services.ErrOutOfDomain},
+		{name: "❌ negative distance per volume", fromUnit: services.MilesPerUSGallon, toUnit: services.LitersPer100Kilometers, value: -5, err: services.ErrOutOfDomain},
+		{name: "❌ mpg needs a gallon", fromUnit: "mpg", toUnit: services.KilometersPerLiter, value: 30, err: services.ErrAmbiguousUnit},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(services.FuelEconomy, test.fromUnit, test.toUnit, test.value)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestFuelEconomyZeroError(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.FuelEconomy, services.LitersPer100Kilometers, services.MilesPerUSGallon, 0)
+	asserts.EqualError(err, "value out of domain: 0 liters-per-100-kilometers has no finite equivalent in miles-per-us-gallon")
+}
+
+func TestExactFuelEconomy(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertDecimal(services.FuelEconomy, services.LitersPer100Kilometers, services.KilometersPerLiter, "6")
+	asserts.NoError(err)
+	asserts.True(actual.Exact)
+	asserts.Equal(0, actual.Value.Cmp(big.NewRat(50, 3)))
+
+	actual, err = services.ConvertDecimal(services.FuelEconomy, services.MilesPerUSGallon, services.LitersPer100Kilometers, "1")
+	asserts.NoError(err)
+	asserts.True(actual.Exact)
+	// 100 km / (1 mile per 3.785411784 L)
+	asserts.Equal(0, actual.Value.Cmp(big.NewRat(2365882365, 10058400)))
+
+	_, err = services.ConvertDecimal(services.FuelEconomy, services.KilometersPerLiter, services.LitersPer100Kilometers, "0")
+	asserts.ErrorIs(err, services.ErrOutOfDomain)
+}
+
+func TestFuelEconomyFromCompoundUnits(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertUnits(5, "L/100km", "km/L")
+	asserts.NoError(err)
+	asserts.Equal(20.0, actual)
+
+	_, err = services.ConvertUnits(20, "mi/gal", "L/100km")
+	asserts.ErrorIs(err, services.ErrAmbiguousUnit)
+
+	actual, err = services.ConvertUnits(5, "L/100km", "km/m³")
+	asserts.NoError(err)
+	asserts.Equal(20000.0, actual)
+
+	actual, err = services.ConvertUnits(20000, "km/m³", "L/100km")
+	asserts.NoError(err)
+	asserts.Equal(5.0, actual)
+
+	_, err = services.ConvertUnits(0, "km/m³", "L/100km")
+	asserts.ErrorIs(err, services.ErrOutOfDomain)
+}
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected services.UnitRef
+		err      error
+	}{
+		{name: "✅ symbol", input: "m", expected: services.UnitRef{Type: services.Length, Unit: services.Meters}},
+		{name: "✅ british spelling", input: "metre", expected: services.UnitRef{Type: services.Length, Unit: services.Meters}},
+		{name: "✅ singular", input: "meter", expected: services.UnitRef{Type: services.Length, Unit: services.Meters}},
+		{name: "✅ capitalized name", input: "Meters", expected: services.UnitRef{Type: services.Length, Unit: services.Meters}},
+		{name: "✅ surrounding spaces", input: " km ", expected: services.UnitRef{Type: services.Length, Unit: services.Kilometers}},
+		{name: "✅ feet symbol", input: "ft", expected: services.UnitRef{Type: services.Length, Unit: services.Feet}},
+		{name: "✅ feet prime", input: "'", expected: services.UnitRef{Type: services.Length, Unit: services.Feet}},
+		{name: "✅ degree fahrenheit", input: "°F", expected: services.UnitRef{Type: services.Temperature, Unit: services.Fahrenheit}},
+		{name: "✅ degF", input: "degF", expected: services.UnitRef{Type: services.Temperature, Unit: services.Fahrenheit}},
+		{name: "✅ pound symbol", input: "lb", expected: services.UnitRef{Type: services.Weight, Unit: services.Pounds}},
+		{name: "✅ pounds plural", input: "lbs", expected: services.UnitRef{Type: services.Weight, Unit: services.Pounds}},
+		{name: "✅ pound sign", input: "#", expected: services.UnitRef{Type: services.Weight, Unit: services.Pounds}},
+		{name: "✅ tonne", input: "tonne", expected: services.UnitRef{Type: services.Weight, Unit: services.Tonnes}},
+		{name: "❌ ton or tonne", input: "t", err: services.ErrAmbiguousUnit},
+		{name: "❌ short or long ton", input: "ton", err: services.ErrAmbiguousUnit},
+		{name: "✅ milliliters", input: "ml", expected: services.UnitRef{Type: services.Volume, Unit: services.Milliliters}},
+		{name: "✅ milliliters symbol", input: "mL", expected: services.UnitRef{Type: services.Volume, Unit: services.Milliliters}},
+		{name: "✅ cubic centimeters", input: "cc", expected: services.UnitRef{Type: services.Volume, Unit: services.CubicCentimeters}},
+		{name: "✅ us gallon", input: "US gal", expected: services.UnitRef{Type: services.Volume, Unit: services.USGallons}},
+		{name: "✅ imperial pint", input: "imperial pint", expected: services.UnitRef{Type: services.Volume, Unit: services.ImperialPints}},
+		{name: "❌ us or imperial gallon", input: "gallon", err: services.ErrAmbiguousUnit},
+		{name: "❌ us or imperial fluid ounce", input: "fl oz", err: services.ErrAmbiguousUnit},
+		{name: "❌ unknown", input: "parsec", err: services.ErrUnknownUnit},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Lookup(test.input)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual)
+		})
+	}
+}
+
+func TestLookupReportsCandidates(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Lookup("t")
+
+	var ambiguous *services.AmbiguousUnitError
+	if asserts.True(errors.As(err, &ambiguous)) {
+		asserts.Equal([]services.UnitRef{
+			{Type: services.Weight, Unit: services.ShortTons},
+			{Type: services.Weight, Unit: services.Tonnes},
+		}, ambiguous.Candidates)
+	}
+	asserts.EqualError(err, `ambiguous unit "t": could be short-tons (weight), tonnes (weight)`)
+}
+
+func TestLookupIn(t *testing.T) {
+	asserts := assert.New(t)
+
+	unit, err := services.LookupIn(services.Temperature, "C")
+	asserts.NoError(err)
+	asserts.Equal(services.Celsius, unit)
+
+	_, err = services.LookupIn(services.Temperature, "m")
+	asserts.ErrorIs(err, services.ErrUnknownUnit)
+
+	_, err = services.LookupIn("invalid", "m")
+	asserts.ErrorIs(err, services.ErrUnknownUnitType)
+}
+
+func TestConvertAcceptsAliases(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.Convert(services.Length, "ft", "m", 10)
+	asserts.NoError(err)
+	asserts.Equal(3.05, actual)
+
+	actual, err = services.Convert(services.Temperature, "°F", "degC", 212)
+	asserts.NoError(err)
+	asserts.Equal(100.0, actual)
+
+	_, err = services.Convert(services.Weight, "t", "kg", 1)
+	asserts.ErrorIs(err, services.ErrAmbiguousUnit)
+
+	_, err = services.Convert(services.Length, "m", "lbs", 1)
+	asserts.ErrorIs(err, services.ErrIncompatibleUnits)
+}
@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypographyConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		opts     []services.ConvertOption
+		expected float64
+		err      error
+	}{
+		{name: "✅ picas to inches", fromUnit: services.Picas, toUnit: services.Inches, value: 6, expected: 1},
+		{name: "✅ points to millimeters", fromUnit: services.Points, toUnit: services.Millimeters, value: 72, expected: 25.4},
+		{
+			name:     "✅ pixels to inches",
+			fromUnit: services.Pixels,
+			toUnit:   services.Inches,
+			value:    96,
+			opts:     []services.ConvertOption{services.WithParameter(services.DPI, 96)},
+			expected: 1,
+		},
+		{
+			name:     "✅ points to pixels",
+			fromUnit: services.Points,
+			toUnit:   services.Pixels,
+			value:    12,
+			opts:     []services.ConvertOption{services.WithParameter(services.DPI, 96)},
+			expected: 16,
+		},
+		{
+			name:     "✅ ems to pixels need the font size only",
+			fromUnit: services.Ems,
+			toUnit:   services.Pixels,
+			value:    2,
+			opts:     []services.ConvertOption{services.WithParameter(services.FontSize, 16)},
+			expected: 32,
+		},
+		{
+			name:     "✅ rems to ems",
+			fromUnit: services.Rems,
+			toUnit:   services.Ems,
+			value:    1.5,
+			opts:     []services.ConvertOption{services.WithParameter(services.RootFontSize, 16), services.WithParameter(services.FontSize, 20)},
+			expected: 1.2,
+		},
+		{
+			name:     "✅ dp to pixels",
+			fromUnit: services.DensityIndependentPixels,
+			toUnit:   services.Pixels,
+			value:    48,
+			opts:     []services.ConvertOption{services.WithParameter(services.Density, 2)},
+			expected: 96,
+		},
+		{
+			name:     "✅ sp to dp",
+			fromUnit: services.ScaleIndependentPixels,
+			toUnit:   services.DensityIndependentPixels,
+			value:    14,
+			opts:     []services.ConvertOption{services.WithParameter(services.FontScale, 1.3)},
+			expected: 18.2,
+		},
+		{
+			name:     "✅ dp to inches on a medium density screen",
+			fromUnit: "dp",
+			toUnit:   "in",
+			value:    160,
+			opts:     []services.ConvertOption{services.WithParameter(services.Density, 1), services.WithParameter(services.DPI, 160)},
+			expected: 1,
+		},
+		{name: "✅ pixels to pixels need nothing", fromUnit: services.Pixels, toUnit: services.Pixels, value: 10, expected: 10},
+		{name: "❌ pixels to inches need the dpi", fromUnit: services.Pixels, toUnit: services.Inches, value: 96, err: services.ErrMissingParameter},
+		{
+			name:     "❌ ems to inches need the dpi too",
+			fromUnit: services.Ems,
+			toUnit:   services.Inches,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithParameter(services.FontSize, 16)},
+			err:      services.ErrMissingParameter,
+		},
+		{
+			name:     "❌ zero dpi",
+			fromUnit: services.Pixels,
+			toUnit:   services.Inches,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithParameter(services.DPI, 0)},
+			err:      services.ErrOutOfDomain,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(services.Length, test.fromUnit, test.toUnit, test.value, test.opts...)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestMissingParameterError(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.Length, services.Ems, services.Inches, 1)
+	asserts.EqualError(err, "missing parameter: converting ems to inches needs dpi, font-size")
+
+	var parameterErr *services.ParameterError
+	asserts.ErrorAs(err, &parameterErr)
+	asserts.Equal([]services.Parameter{services.DPI, services.FontSize}, parameterErr.Parameters)
+}
+
+func TestParametersAreNotExact(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertDecimal(services.Length, services.Pixels, services.Inches, "48", services.WithParameter(services.DPI, 96))
+	asserts.NoError(err)
+	asserts.False(actual.Exact)
+	asserts.Equal("0.5", actual.Decimal(1))
+
+	actual, err = services.ConvertDecimal(services.Length, services.Points, services.Picas, "1")
+	asserts.NoError(err)
+	asserts.True(actual.Exact)
+	asserts.Equal("1/12", actual.Value.RatString())
+}
+
+func TestParametersInQueriesAndCompoundUnits(t *testing.T) {
+	asserts := assert.New(t)
+
+	conversion, err := services.ParseConversion("12 points to px")
+	asserts.NoError(err)
+	actual, err := conversion.Convert(services.WithParameter(services.DPI, 96))
+	asserts.NoError(err)
+	asserts.Equal(16.0, actual)
+
+	actual, err = services.ConvertUnits(96, "px", "in", services.WithParameter(services.DPI, 96))
+	asserts.NoError(err)
+	asserts.Equal(1.0, actual)
+
+	_, err = services.ConvertUnits(96, "px/s", "m/s", services.WithParameter(services.DPI, 96))
+	asserts.ErrorIs(err, services.ErrIncompatibleUnits)
+}
@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected services.Quantity
+		err      error
+	}{
+		{
+			name:     "✅ value and symbol",
+			input:    "10 km",
+			expected: services.Quantity{Value: 10, Unit: services.Kilometers, Type: services.Length},
+		},
+		{
+			name:     "✅ scientific notation",
+			input:    "1.5e3 km",
+			expected: services.Quantity{Value: 1500, Unit: services.Kilometers, Type: services.Length},
+		},
+		{
+			name:     "✅ degree sign without space",
+			input:    "72°F",
+			expected: services.Quantity{Value: 72, Unit: services.Fahrenheit, Type: services.Temperature},
+		},
+		{
+			name:     "✅ negative value",
+			input:    "-40 °C",
+			expected: services.Quantity{Value: -40, Unit: services.Celsius, Type: services.Temperature},
+		},
+		{
+			name:     "✅ leading decimal point",
+			input:    ".5 kg",
+			expected: services.Quantity{Value: 0.5, Unit: services.Kilograms, Type: services.Weight},
+		},
+		{
+			name:     "✅ multi-word unit",
+			input:    "3 metric tons",
+			expected: services.Quantity{Value: 3, Unit: services.Tonnes, Type: services.Weight},
+		},
+		{
+			name:     "✅ unit with digits after a slash",
+			input:    "3.5 L/100km",
+			expected: services.Quantity{Value: 3.5, Unit: services.LitersPer100Kilometers, Type: services.FuelEconomy},
+		},
+		{
+			name:     "✅ unit with digits and a space after a slash",
+			input:    "3.5 L/100 km",
+			expected: services.Quantity{Value: 3.5, Unit: services.LitersPer100Kilometers, Type: services.FuelEconomy},
+		},
+		{
+			name:     "✅ compound pounds and ounces",
+			input:    "5 lb 8 oz",
+			expected: services.Quantity{Value: 5.5, Unit: services.Pounds, Type: services.Weight},
+		},
+		{
+			name:     "✅ compound miles and yards",
+			input:    "1 mi 880 yd",
+			expected: services.Quantity{Value: 1.5, Unit: services.Miles, Type: services.Length},
+		},
+		{
+			name:     "✅ compound feet and inches",
+			input:    "5 ft 3 in",
+			expected: services.Quantity{Value: 5.25, Unit: services.Feet, Type: services.Length},
+		},
+		{
+			name:     "✅ feet and inches marks",
+			input:    `6'6"`,
+			expected: services.Quantity{Value: 6.5, Unit: services.Feet, Type: services.Length},
+		},
+		{
+			name:  "❌ empty",
+			input: "  ",
+			err:   services.ErrInvalidQuantity,
+		},
+		{
+			name:  "❌ missing unit",
+			input: "10",
+			err:   services.ErrInvalidQuantity,
+		},
+		{
+			name:  "❌ missing value",
+			input: "km",
+			err:   services.ErrInvalidQuantity,
+		},
+		{
+			name:  "❌ unknown unit",
+			input: "10 parsecs",
+			err:   services.ErrUnknownUnit,
+		},
+		{
+			name:  "❌ ambiguous unit",
+			input: "2 t",
+			err:   services.ErrAmbiguousUnit,
+		},
+		{
+			name:  "❌ mixed unit types",
+			input: "5 ft 3 lb",
+			err:   services.ErrIncompatibleUnits,
+		},
+		{
+			name:  "❌ affine terms",
+			input: "10 °C 5 °F",
+			err:   services.ErrIncompatibleUnits,
+		},
+		{
+			name:  "❌ inverse terms",
+			input: "5 L/100km 2 L/100km",
+			err:   services.ErrIncompatibleUnits,
+		},
+		{
+			name:  "❌ table-backed terms",
+			input: "4 gas mark 1 gas mark",
+			err:   services.ErrIncompatibleUnits,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Parse(test.input)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected.Unit, actual.Unit)
+			asserts.Equal(test.expected.Type, actual.Type)
+			asserts.InDelta(test.expected.Value, actual.Value, 1e-12)
+		})
+	}
+}
+
+func TestParseConversion(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected services.Conversion
+		result   float64
+		err      error
+	}{
+		{
+			name:     "✅ to",
+			input:    "10 km to mi",
+			expected: services.Conversion{From: services.Quantity{Value: 10, Unit: services.Kilometers, Type: services.Length}, To: services.Miles},
+			result:   6.21,
+		},
+		{
+			name:     "✅ in",
+			input:    "72°F in °C",
+			expected: services.Conversion{From: services.Quantity{Value: 72, Unit: services.Fahrenheit, Type: services.Temperature}, To: services.Celsius},
+			result:   22.22,
+		},
+		{
+			name:     "✅ inches as a unit and as a separator",
+			input:    "12 in in cm",
+			expected: services.Conversion{From: services.Quantity{Value: 12, Unit: services.Inches, Type: services.Length}, To: services.Centimeters},
+			result:   30.48,
+		},
+		{
+			name:     "✅ arrow",
+			input:    "5 lb 8 oz -> kg",
+			expected: services.Conversion{From: services.Quantity{Value: 5.5, Unit: services.Pounds, Type: services.Weight}, To: services.Kilograms},
+			result:   2.49,
+		},
+		{
+			name:  "❌ no target",
+			input: "10 km",
+			err:   services.ErrInvalidQuantity,
+		},
+		{
+			name:  "❌ target of another type",
+			input: "10 km to lb",
+			err:   services.ErrIncompatibleUnits,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.ParseConversion(test.input)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual)
+
+			result, err := actual.Convert()
+			asserts.NoError(err)
+			asserts.Equal(test.result, result)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixedLookup(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected services.UnitRef
+		err      error
+	}{
+		{name: "✅ micro sign", input: "µm", expected: services.UnitRef{Type: services.Length, Unit: "micrometers"}},
+		{name: "✅ greek mu", input: "μm", expected: services.UnitRef{Type: services.Length, Unit: "micrometers"}},
+		{name: "✅ ascii u", input: "um", expected: services.UnitRef{Type: services.Length, Unit: "micrometers"}},
+		{name: "✅ prefixed name", input: "micrometers", expected: services.UnitRef{Type: services.Length, Unit: "micrometers"}},
+		{name: "✅ prefixed alias", input: "kilometre", expected: services.UnitRef{Type: services.Length, Unit: services.Kilometers}},
+		{name: "✅ mega is not milli", input: "Mm", expected: services.UnitRef{Type: services.Length, Unit: "megameters"}},
+		{name: "✅ milli is not mega", input: "mm", expected: services.UnitRef{Type: services.Length, Unit: "millimeters"}},
+		{name: "✅ nanograms", input: "ng", expected: services.UnitRef{Type: services.Weight, Unit: "nanograms"}},
+		{name: "✅ gigagrams", input: "Gg", expected: services.UnitRef{Type: services.Weight, Unit: "gigagrams"}},
+		{name: "✅ kilowatt-hours", input: "kWh", expected: services.UnitRef{Type: services.Energy, Unit: services.KilowattHours}},
+		{name: "✅ alternate symbol", input: "ul", expected: services.UnitRef{Type: services.Volume, Unit: "microliters"}},
+		{name: "✅ prefixed word alias", input: "kilowatt-hour", expected: services.UnitRef{Type: services.Energy, Unit: services.KilowattHours}},
+		{name: "✅ milliseconds", input: "ms", expected: services.UnitRef{Type: services.Time, Unit: "milliseconds"}},
+		{name: "❌ unprefixed unit", input: "kft", err: services.ErrUnknownUnit},
+		{name: "❌ unknown prefix", input: "xm", err: services.ErrUnknownUnit},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Lookup(test.input)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestPrefixedConvert(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.Convert(services.Length, "µm", "mm", 1500)
+	asserts.NoError(err)
+	asserts.Equal(1.5, actual)
+
+	actual, err = services.Convert(services.Weight, "nanograms", services.Milligrams, 2500000, services.WithoutRounding())
+	asserts.NoError(err)
+	asserts.Equal(2.5, actual)
+
+	exact, err := services.ConvertDecimal(services.Length, "nanometers", services.Feet, "304800000")
+	asserts.NoError(err)
+	asserts.True(exact.Exact)
+	asserts.Equal(0, exact.Value.Cmp(big.NewRat(1, 1)))
+}
+
+func TestPrefixFactor(t *testing.T) {
+	asserts := assert.New(t)
+
+	asserts.Equal(0, services.SIPrefixes[0].Factor().Cmp(new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil))))
+	asserts.Equal(0, services.BinaryPrefixes[0].Factor().Cmp(big.NewRat(1024, 1)))
+
+	definition, ok := services.Definition(services.Energy, services.KilowattHours)
+	asserts.True(ok)
+	asserts.Equal(3600000.0, definition.Factor)
+	asserts.Equal("kWh", definition.Symbol)
+
+	_, ok = services.Definition(services.Length, "kilofeet")
+	asserts.False(ok)
+}
+
+func TestBinaryPrefixes(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.Convert(services.Data, "MiB", "kB", 1)
+	asserts.NoError(err)
+	asserts.Equal(1048.58, actual)
+
+	actual, err = services.Convert(services.Data, "gibibytes", "KiB", 1)
+	asserts.NoError(err)
+	asserts.Equal(1048576.0, actual)
+
+	_, err = services.Convert(services.Length, "kibimeters", services.Meters, 1)
+	asserts.ErrorIs(err, services.ErrUnknownUnit)
+}
+
+func TestUnitsAreSortedBySize(t *testing.T) {
+	asserts := assert.New(t)
+
+	units := services.Units(services.Length)
+	asserts.Equal(services.Unit("quectometers"), units[0])
+	asserts.Equal(services.Unit("quettameters"), units[len(units)-1])
+	asserts.Contains(units, services.Miles)
+
+	for i := 1; i < len(units); i++ {
+		previous, _ := services.Definition(services.Length, units[i-1])
+		current, _ := services.Definition(services.Length, units[i])
+		asserts.LessOrEqual(previous.Factor, current.Factor)
+	}
+}
+
+func TestRegistryChangesAreSeen(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.Length, services.Kilometers, services.Meters, 1)
+	asserts.NoError(err)
+
+	services.Registry[services.Length]["parsecs"] = services.Rational("30856775814913673").Named("pc").WithPrefixes(services.SIPrefixed)
+	defer delete(services.Registry[services.Length], "parsecs")
+
+	actual, err := services.Convert(services.Length, "kiloparsecs", "parsecs", 1)
+	asserts.NoError(err)
+	asserts.Equal(1000.0, actual)
+
+	luminance := services.UnitType("luminance")
+	services.Registry[luminance] = map[services.Unit]services.UnitDefinition{
+		"candelas-per-square-meter": services.Rational("1").Named("cd/m²", "nits"),
+		"foot-lamberts":             services.Rational("3.42625909963539").Named("fL"),
+	}
+	defer delete(services.Registry, luminance)
+
+	actual, err = services.Convert(luminance, "fL", "nits", 1)
+	asserts.NoError(err)
+	asserts.Equal(3.43, actual)
+
+	delete(services.Registry[services.Length], "parsecs")
+	_, err = services.Convert(services.Length, "parsecs", services.Meters, 1)
+	asserts.ErrorIs(err, services.ErrUnknownUnit)
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPressureConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		opts     []services.ConvertOption
+		expected float64
+		err      error
+	}{
+		{name: "✅ atmospheres to pascals", fromUnit: services.Atmospheres, toUnit: services.Pascals, value: 1, expected: 101325},
+		{name: "✅ atmospheres to torr", fromUnit: services.Atmospheres, toUnit: services.Torr, value: 1, expected: 760},
+		{name: "✅ bars to kilopascals", fromUnit: services.Bars, toUnit: services.Kilopascals, value: 2.5, expected: 250},
+		{name: "✅ millibars to hectopascals", fromUnit: services.Millibars, toUnit: services.Hectopascals, value: 1013.25, expected: 1013.25},
+		{name: "✅ megapascals to psi", fromUnit: services.Megapascals, toUnit: services.PoundsPerSquareInch, value: 1, expected: 145.04},
+		{name: "✅ psi to kilopascals", fromUnit: services.PoundsPerSquareInch, toUnit: services.Kilopascals, value: 14.7, expected: 101.35},
+		{name: "✅ inches of mercury to millimeters of mercury", fromUnit: services.InchesOfMercury, toUnit: services.MillimetersOfMercury, value: 1, expected: 25.4},
+		{name: "✅ millimeters of mercury to torr", fromUnit: services.MillimetersOfMercury, toUnit: services.Torr, value: 760, expected: 760},
+		{name: "✅ centimeters of water to pascals", fromUnit: services.CentimetersOfWater, toUnit: services.Pascals, value: 10, expected: 980.67},
+		{name: "✅ symbols", fromUnit: "kPa", toUnit: "mbar", value: 1, expected: 10},
+		{
+			name:     "✅ gauge psi to absolute bars",
+			fromUnit: services.PoundsPerSquareInch,
+			toUnit:   services.Bars,
+			value:    32,
+			opts:     []services.ConvertOption{services.WithGaugeFrom()},
+			expected: 3.22,
+		},
+		{
+			name:     "✅ absolute pascals to gauge",
+			fromUnit: services.Pascals,
+			toUnit:   services.Kilopascals,
+			value:    200000,
+			opts:     []services.ConvertOption{services.WithGaugeTo()},
+			expected: 98.68,
+		},
+		{
+			name:     "✅ gauge to gauge in the same unit",
+			fromUnit: services.Bars,
+			toUnit:   services.Bars,
+			value:    2,
+			opts:     []services.ConvertOption{services.WithGaugeFrom(), services.WithGaugeTo()},
+			expected: 2,
+		},
+		{
+			name:     "✅ gauge to absolute in the same unit",
+			fromUnit: services.Atmospheres,
+			toUnit:   services.Atmospheres,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithGaugeFrom()},
+			expected: 2,
+		},
+		{
+			name:     "✅ partial vacuum as a negative gauge",
+			fromUnit: services.Kilopascals,
+			toUnit:   services.Kilopascals,
+			value:    -50,
+			opts:     []services.ConvertOption{services.WithGaugeFrom()},
+			expected: 51.33,
+		},
+		{name: "❌ below vacuum", fromUnit: services.Pascals, toUnit: services.Bars, value: -1, err: services.ErrOutOfDomain},
+		{
+			name:     "❌ gauge below vacuum",
+			fromUnit: services.Bars,
+			toUnit:   services.Pascals,
+			value:    -1.1,
+			opts:     []services.ConvertOption{services.WithGaugeFrom()},
+			err:      services.ErrOutOfDomain,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(services.Pressure, test.fromUnit, test.toUnit, test.value, test.opts...)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestGaugeOnlyAppliesToPressure(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.Length, services.Meters, services.Feet, 1, services.WithGaugeFrom())
+	asserts.ErrorIs(err, services.ErrIncompatibleUnits)
+}
+
+func TestGaugePressureIsExact(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertDecimal(services.Pressure, services.Atmospheres, services.Pascals, "2", services.WithGaugeFrom())
+	asserts.NoError(err)
+	asserts.True(actual.Exact)
+	asserts.Equal(0, actual.Value.Cmp(big.NewRat(303975, 1)))
+
+	_, err = services.Convert(services.Pressure, services.Bars, services.Pascals, -1.5, services.WithGaugeFrom())
+	asserts.EqualError(err, "value out of domain: -1.5 bars is below the minimum of -1.01325 bars for pressure")
+}
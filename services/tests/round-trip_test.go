@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+// ulp returns the distance from x to the next representable float64 away from zero
+func ulp(x float64) float64 {
+	x = math.Abs(x)
+	return math.Nextafter(x, math.Inf(1)) - x
+}
+
+func TestExactDefinitions(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		unitType services.UnitType
+		unit     services.Unit
+		value    float64
+		expected float64
+	}{
+		{name: "✅ 1 foot is 0.3048 meters", unitType: services.Length, unit: services.Feet, value: 1, expected: 0.3048},
+		{name: "✅ 1 yard is 0.9144 meters", unitType: services.Length, unit: services.Yards, value: 1, expected: 0.9144},
+		{name: "✅ 1 mile is 1609.344 meters", unitType: services.Length, unit: services.Miles, value: 1, expected: 1609.344},
+		{name: "✅ 1 inch is 0.0254 meters", unitType: services.Length, unit: services.Inches, value: 1, expected: 0.0254},
+		{name: "✅ 1 thou is 0.0000254 meters", unitType: services.Length, unit: services.Thou, value: 1, expected: 0.0000254},
+		{name: "✅ 1 fathom is 1.8288 meters", unitType: services.Length, unit: services.Fathoms, value: 1, expected: 1.8288},
+		{name: "✅ 1 chain is 20.1168 meters", unitType: services.Length, unit: services.Chains, value: 1, expected: 20.1168},
+		{name: "✅ 1 furlong is 201.168 meters", unitType: services.Length, unit: services.Furlongs, value: 1, expected: 201.168},
+		{name: "✅ 1 nautical mile is 1852 meters", unitType: services.Length, unit: services.NauticalMiles, value: 1, expected: 1852},
+		{name: "✅ 3937 US survey feet are 1200 meters", unitType: services.Length, unit: services.USSurveyFeet, value: 3937, expected: 1200},
+		{name: "✅ 1 acre is 4046.8564224 square meters", unitType: services.Area, unit: services.Acres, value: 1, expected: 4046.8564224},
+		{name: "✅ 1 cubic foot is 0.028316846592 cubic meters", unitType: services.Volume, unit: services.CubicFeet, value: 1, expected: 0.028316846592},
+		{name: "✅ 1 week is 604800 seconds", unitType: services.Time, unit: services.Weeks, value: 1, expected: 604800},
+		{name: "✅ 1 julian year is 365.25 days", unitType: services.Time, unit: services.JulianYears, value: 1, expected: 365.25 * 86400},
+		{name: "✅ 1 gregorian year is 365.2425 days", unitType: services.Time, unit: services.GregorianYears, value: 1, expected: 365.2425 * 86400},
+		{name: "✅ 1 pound is 453.59237 grams", unitType: services.Weight, unit: services.Pounds, value: 1, expected: 453.59237},
+		{name: "✅ 1 ounce is 28.349523125 grams", unitType: services.Weight, unit: services.Ounces, value: 1, expected: 28.349523125},
+		{name: "✅ 5 liters per 100 km are 20 km per liter", unitType: services.FuelEconomy, unit: services.LitersPer100Kilometers, value: 5, expected: 20},
+		{name: "✅ 0 celsius is 273.15 kelvin", unitType: services.Temperature, unit: services.Celsius, value: 0, expected: 273.15},
+		{name: "✅ -459.67 fahrenheit is 0 kelvin", unitType: services.Temperature, unit: services.Fahrenheit, value: -459.67, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := services.Registry[test.unitType][test.unit].ToBase(test.value)
+			asserts.InDelta(test.expected, actual, ulp(test.expected), test.name)
+		})
+	}
+}
+
+func TestRoundTripEveryPair(t *testing.T) {
+	asserts := assert.New(t)
+
+	values := []float64{0, 1, 0.1, 98.6, 123.456, 1e6, 1e-6, 6.02214076e23}
+
+	for unitType := range services.Registry {
+		units := services.Units(unitType)
+		for _, fromUnit := range units {
+			from, _ := services.Definition(unitType, fromUnit)
+			for _, toUnit := range units {
+				to, _ := services.Definition(unitType, toUnit)
+				if from.Table != nil || to.Table != nil {
+					continue // tables only cover their entries, see TestTableRoundTripsEveryEntry
+				}
+				t.Run(fmt.Sprintf("✅ %s %s to %s and back", unitType, fromUnit, toUnit), func(t *testing.T) {
+					for _, value := range values {
+						if value == 0 && (from.Inverse || to.Inverse) {
+							continue // zero has no finite inverse
+						}
+
+						base := from.ToBase(value)
+						converted := to.FromBase(base)
+						back := from.FromBase(to.ToBase(converted))
+
+						// Affine units pass through the base unit, so the
+						// tolerance follows the largest magnitude involved,
+						// measured in the unit converted from. Prefixed
+						// factors such as 10⁻³⁰ are not exact in binary and
+						// cost a few more ulps. Descending scales such as
+						// Delisle have negative factors.
+						fromFactor, toFactor := math.Abs(from.Factor), math.Abs(to.Factor)
+						scale := math.Max(math.Abs(value), math.Max(math.Abs(base)/fromFactor, math.Abs(converted)*toFactor/fromFactor))
+						asserts.InDelta(value, back, 4*ulp(scale), "%v %s -> %v %s -> %v %s", value, fromUnit, converted, toUnit, back, fromUnit)
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertRounding(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		unitType services.UnitType
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		opts     []services.ConvertOption
+		expected float64
+	}{
+		{
+			name:     "✅ two decimals by default",
+			unitType: services.Length,
+			fromUnit: services.Miles,
+			toUnit:   services.Kilometers,
+			value:    1,
+			expected: 1.61,
+		},
+		{
+			name:     "✅ no rounding keeps small values",
+			unitType: services.Weight,
+			fromUnit: services.Milligrams,
+			toUnit:   services.Kilograms,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithoutRounding()},
+			expected: 1e-6,
+		},
+		{
+			name:     "✅ four decimals",
+			unitType: services.Length,
+			fromUnit: services.Miles,
+			toUnit:   services.Kilometers,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithDecimals(4)},
+			expected: 1.6093,
+		},
+		{
+			name:     "✅ zero decimals",
+			unitType: services.Length,
+			fromUnit: services.Miles,
+			toUnit:   services.Kilometers,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithDecimals(0)},
+			expected: 2,
+		},
+		{
+			name:     "✅ negative decimals round to hundreds",
+			unitType: services.Length,
+			fromUnit: services.Miles,
+			toUnit:   services.Meters,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithDecimals(-2)},
+			expected: 1600,
+		},
+		{
+			name:     "✅ significant figures on small values",
+			unitType: services.Weight,
+			fromUnit: services.Milligrams,
+			toUnit:   services.Pounds,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithSignificantFigures(3)},
+			expected: 2.2e-6,
+		},
+		{
+			name:     "✅ significant figures on large values",
+			unitType: services.Length,
+			fromUnit: services.Miles,
+			toUnit:   services.Meters,
+			value:    1000,
+			opts:     []services.ConvertOption{services.WithSignificantFigures(3)},
+			expected: 1610000,
+		},
+		{
+			name:     "✅ floor",
+			unitType: services.Length,
+			fromUnit: services.Miles,
+			toUnit:   services.Kilometers,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithDecimals(1), services.WithRoundingMode(services.RoundFloor)},
+			expected: 1.6,
+		},
+		{
+			name:     "✅ ceil",
+			unitType: services.Length,
+			fromUnit: services.Miles,
+			toUnit:   services.Kilometers,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithDecimals(1), services.WithRoundingMode(services.RoundCeil)},
+			expected: 1.7,
+		},
+		{
+			name:     "✅ truncate negative values towards zero",
+			unitType: services.Temperature,
+			fromUnit: services.Fahrenheit,
+			toUnit:   services.Celsius,
+			value:    0,
+			opts:     []services.ConvertOption{services.WithDecimals(1), services.WithRoundingMode(services.RoundTruncate)},
+			expected: -17.7,
+		},
+		{
+			name:     "✅ half up rounds halves away from zero",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   services.Meters,
+			value:    2.5,
+			opts:     []services.ConvertOption{services.WithDecimals(0)},
+			expected: 3,
+		},
+		{
+			name:     "✅ half even rounds halves to even",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   services.Meters,
+			value:    2.5,
+			opts:     []services.ConvertOption{services.WithDecimals(0), services.WithRoundingMode(services.RoundHalfEven)},
+			expected: 2,
+		},
+		{
+			name:     "✅ decimal halves round as written",
+			unitType: services.Weight,
+			fromUnit: services.Grams,
+			toUnit:   services.Grams,
+			value:    1.005,
+			opts:     []services.ConvertOption{services.WithDecimals(2)},
+			expected: 1.01,
+		},
+		{
+			name:     "✅ negative decimal halves round away from zero",
+			unitType: services.Weight,
+			fromUnit: services.Grams,
+			toUnit:   services.Grams,
+			value:    -1.005,
+			opts:     []services.ConvertOption{services.WithDecimals(2), services.WithSigned()},
+			expected: -1.01,
+		},
+		{
+			name:     "✅ decimal halves round to even",
+			unitType: services.Weight,
+			fromUnit: services.Grams,
+			toUnit:   services.Grams,
+			value:    1.025,
+			opts:     []services.ConvertOption{services.WithDecimals(2), services.WithRoundingMode(services.RoundHalfEven)},
+			expected: 1.02,
+		},
+		{
+			name:     "✅ decimal halves with significant figures",
+			unitType: services.Weight,
+			fromUnit: services.Grams,
+			toUnit:   services.Grams,
+			value:    1.005,
+			opts:     []services.ConvertOption{services.WithSignificantFigures(3)},
+			expected: 1.01,
+		},
+		{
+			name:     "✅ float noise does not hide a half",
+			unitType: services.Weight,
+			fromUnit: services.Grams,
+			toUnit:   services.Grams,
+			value:    226.8 / 16,
+			opts:     []services.ConvertOption{services.WithDecimals(2)},
+			expected: 14.18,
+		},
+		{
+			name:     "✅ floor of a negative value",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   services.Meters,
+			value:    -1.001,
+			opts:     []services.ConvertOption{services.WithDecimals(2), services.WithSigned(), services.WithRoundingMode(services.RoundFloor)},
+			expected: -1.01,
+		},
+		{
+			name:     "✅ decimals finer than float64 keep the value",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   services.Meters,
+			value:    0.1,
+			opts:     []services.ConvertOption{services.WithDecimals(20)},
+			expected: 0.1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value, test.opts...)
+			asserts.NoError(err)
+			asserts.InDelta(test.expected, actual, 1e-12, test.name)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubstanceConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name      string
+		unitType  services.UnitType
+		fromUnit  services.Unit
+		toUnit    services.Unit
+		value     float64
+		substance string
+		expected  float64
+		err       error
+	}{
+		{name: "✅ cups of flour to grams", unitType: services.Volume, fromUnit: services.USCups, toUnit: services.Grams, value: 2, substance: "flour", expected: 250},
+		{name: "✅ grams of sugar to cups", unitType: services.Weight, fromUnit: services.Grams, toUnit: services.USCups, value: 100, substance: "sugar", expected: 0.5},
+		{name: "✅ pounds of butter to cups", unitType: services.Weight, fromUnit: services.Pounds, toUnit: services.USCups, value: 1, substance: "butter", expected: 2},
+		{name: "✅ tablespoons of butter to grams", unitType: services.Volume, fromUnit: services.USTablespoons, toUnit: services.Grams, value: 1, substance: "butter", expected: 14.18},
+		{name: "✅ milliliters of water to grams", unitType: services.Volume, fromUnit: services.Milliliters, toUnit: services.Grams, value: 500, substance: "water", expected: 500},
+		{name: "✅ kilograms of rice to liters", unitType: services.Weight, fromUnit: services.Kilograms, toUnit: services.Liters, value: 1, substance: "rice", expected: 1.28},
+		{name: "✅ symbols and aliases", unitType: services.Volume, fromUnit: "US cup", toUnit: "g", value: 1, substance: "Icing Sugar", expected: 120},
+		{name: "✅ same unit type ignores the density", unitType: services.Volume, fromUnit: services.USCups, toUnit: services.Milliliters, value: 1, substance: "flour", expected: 236.59},
+		{name: "❌ unknown substance", unitType: services.Volume, fromUnit: services.USCups, toUnit: services.Grams, value: 1, substance: "sand", err: services.ErrUnknownSubstance},
+		{name: "❌ no substance", unitType: services.Volume, fromUnit: services.USCups, toUnit: services.Grams, value: 1, err: services.ErrIncompatibleUnits},
+		{name: "❌ lengths have no density", unitType: services.Length, fromUnit: services.Meters, toUnit: services.Grams, value: 1, substance: "flour", err: services.ErrIncompatibleUnits},
+		{name: "❌ negative volume", unitType: services.Volume, fromUnit: services.USCups, toUnit: services.Grams, value: -1, substance: "flour", err: services.ErrOutOfDomain},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var opts []services.ConvertOption
+			if test.substance != "" {
+				opts = append(opts, services.WithSubstance(test.substance))
+			}
+
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value, opts...)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestSubstanceConversionsAreNotExact(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertDecimal(services.Volume, services.USCups, services.Grams, "2", services.WithSubstance("flour"))
+	asserts.NoError(err)
+	asserts.False(actual.Exact)
+	asserts.Equal("250.000", actual.Decimal(3))
+}
+
+func TestSubstanceNames(t *testing.T) {
+	asserts := assert.New(t)
+
+	names := services.SubstanceNames()
+	asserts.IsIncreasing(names)
+	asserts.Contains(names, "flour")
+
+	for _, name := range names {
+		substance, err := services.LookupSubstance(name)
+		asserts.NoError(err)
+		asserts.Positive(substance.Density, name)
+	}
+}
@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	nearest := []services.ConvertOption{services.WithTableLookup(services.NearestLookup)}
+	exact := []services.ConvertOption{services.WithTableLookup(services.ExactLookup)}
+
+	tests := []struct {
+		name     string
+		unitType services.UnitType
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		opts     []services.ConvertOption
+		expected float64
+		err      error
+	}{
+		{name: "✅ US to UK shoe size", unitType: services.ShoeSize, fromUnit: services.USShoeSize, toUnit: services.UKShoeSize, value: 9, expected: 8},
+		{name: "✅ US to EU shoe size", unitType: services.ShoeSize, fromUnit: services.USShoeSize, toUnit: services.EUShoeSize, value: 9, expected: 42},
+		{name: "✅ EU to JP shoe size", unitType: services.ShoeSize, fromUnit: services.EUShoeSize, toUnit: services.JPShoeSize, value: 42, expected: 26.2},
+		{name: "✅ UK shoe size to mondopoint", unitType: services.ShoeSize, fromUnit: services.UKShoeSize, toUnit: services.Mondopoint, value: 8, expected: 262},
+		{name: "✅ half sizes interpolate", unitType: services.ShoeSize, fromUnit: services.USShoeSize, toUnit: services.UKShoeSize, value: 9.25, expected: 8.25},
+		{name: "✅ mondopoint between entries interpolates", unitType: services.ShoeSize, fromUnit: services.Mondopoint, toUnit: services.USShoeSize, value: 259, expected: 8.63},
+		{name: "✅ mondopoint between entries to the nearest", unitType: services.ShoeSize, fromUnit: services.Mondopoint, toUnit: services.USShoeSize, value: 259, opts: nearest, expected: 8.5},
+		{name: "✅ sizes between entries to the nearest", unitType: services.ShoeSize, fromUnit: services.USShoeSize, toUnit: services.Mondopoint, value: 9.4, opts: nearest, expected: 267},
+		{name: "✅ exact lookup of an entry", unitType: services.ShoeSize, fromUnit: services.EUShoeSize, toUnit: services.USShoeSize, value: 42, opts: exact, expected: 9},
+		{name: "✅ US to ISO ring size", unitType: services.RingSize, fromUnit: services.USRingSize, toUnit: services.ISORingSize, value: 7, expected: 54.7},
+		{name: "✅ JP to US ring size", unitType: services.RingSize, fromUnit: services.JPRingSize, toUnit: services.USRingSize, value: 13, expected: 6.9},
+		{name: "✅ gas mark to celsius", unitType: services.Temperature, fromUnit: services.GasMark, toUnit: services.Celsius, value: 4, expected: 176.67},
+		{name: "✅ fractional gas marks", unitType: services.Temperature, fromUnit: "gas mark", toUnit: "°F", value: 0.25, expected: 225},
+		{name: "✅ celsius to gas mark", unitType: services.Temperature, fromUnit: services.Celsius, toUnit: services.GasMark, value: 180, expected: 4.24},
+		{name: "✅ celsius to the nearest gas mark", unitType: services.Temperature, fromUnit: services.Celsius, toUnit: services.GasMark, value: 180, opts: nearest, expected: 4},
+		{name: "✅ kelvin to an exact gas mark", unitType: services.Temperature, fromUnit: services.Kelvin, toUnit: services.GasMark, value: 408.15, opts: exact, expected: 1},
+		{name: "❌ size above the table", unitType: services.ShoeSize, fromUnit: services.USShoeSize, toUnit: services.UKShoeSize, value: 20, err: services.ErrOutsideTable},
+		{name: "❌ size below the table", unitType: services.ShoeSize, fromUnit: services.USShoeSize, toUnit: services.UKShoeSize, value: 1, err: services.ErrOutsideTable},
+		{name: "❌ length beyond the target table", unitType: services.ShoeSize, fromUnit: services.Mondopoint, toUnit: services.EUShoeSize, value: 400, err: services.ErrOutsideTable},
+		{name: "❌ oven too hot for gas marks", unitType: services.Temperature, fromUnit: services.Celsius, toUnit: services.GasMark, value: 300, err: services.ErrOutsideTable},
+		{name: "❌ exact lookup between entries", unitType: services.ShoeSize, fromUnit: services.USShoeSize, toUnit: services.UKShoeSize, value: 9.25, opts: exact, err: services.ErrBetweenEntries},
+		{name: "❌ exact lookup converting between entries", unitType: services.Temperature, fromUnit: services.Celsius, toUnit: services.GasMark, value: 180, opts: exact, err: services.ErrBetweenEntries},
+		{name: "❌ gas marks are not intervals", unitType: services.Temperature, fromUnit: services.GasMark, toUnit: services.Celsius, value: 4, opts: []services.ConvertOption{services.WithInterval()}, err: services.ErrIncompatibleUnits},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value, test.opts...)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestTableError(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.ShoeSize, services.USShoeSize, services.UKShoeSize, 20)
+	asserts.EqualError(err, "value outside the table: 20 us-shoe-size is beyond the table of us-shoe-size, which goes from 3.5 to 15")
+
+	_, err = services.Convert(services.ShoeSize, services.Mondopoint, services.USShoeSize, 259, services.WithTableLookup(services.ExactLookup))
+	asserts.EqualError(err, "value between table entries: 259 mondopoint falls between the entries 8.5 and 9 of us-shoe-size")
+
+	var tableErr *services.TableError
+	asserts.ErrorAs(err, &tableErr)
+	asserts.Equal(services.USShoeSize, tableErr.Target)
+	asserts.Equal(8.5, tableErr.Low)
+	asserts.Equal(9.0, tableErr.High)
+}
+
+func TestConvertTableReportsValuesBetweenEntries(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertTable(services.ShoeSize, services.USShoeSize, services.EUShoeSize, 9)
+	asserts.NoError(err)
+	asserts.Equal(services.TableResult{Value: 42, Between: false}, actual)
+
+	actual, err = services.ConvertTable(services.Temperature, services.Celsius, services.GasMark, 180, services.WithTableLookup(services.NearestLookup))
+	asserts.NoError(err)
+	asserts.Equal(services.TableResult{Value: 4, Between: true}, actual)
+
+	actual, err = services.ConvertTable(services.ShoeSize, services.Mondopoint, services.USShoeSize, 259)
+	asserts.NoError(err)
+	asserts.Equal(services.TableResult{Value: 8.63, Between: true}, actual)
+
+	_, err = services.ConvertTable(services.ShoeSize, services.USShoeSize, services.EUShoeSize, 30)
+	asserts.ErrorIs(err, services.ErrOutsideTable)
+}
+
+func TestTableRoundTripsEveryEntry(t *testing.T) {
+	asserts := assert.New(t)
+
+	for unitType := range services.Registry {
+		for _, unit := range services.Units(unitType) {
+			definition, _ := services.Definition(unitType, unit)
+			if definition.Table == nil {
+				continue
+			}
+
+			for _, size := range definition.Table.Sizes {
+				back := definition.FromBase(definition.ToBase(size))
+				asserts.InDelta(size, back, 1e-9, "%v %s", size, unit)
+			}
+		}
+	}
+}
+
+func TestTablesAreNotExact(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertDecimal(services.ShoeSize, services.USShoeSize, services.UKShoeSize, "9")
+	asserts.NoError(err)
+	asserts.False(actual.Exact)
+	asserts.Equal("8", actual.Decimal(0))
+}
+
+func TestTablesInQueriesAndCompoundUnits(t *testing.T) {
+	asserts := assert.New(t)
+
+	conversion, err := services.ParseConversion("4 gas mark to °C")
+	asserts.NoError(err)
+	actual, err := conversion.Convert()
+	asserts.NoError(err)
+	asserts.Equal(176.67, actual)
+
+	actual, err = services.ConvertUnits(260, "MP", "cm")
+	asserts.NoError(err)
+	asserts.Equal(26.0, actual)
+
+	_, err = services.ConvertUnits(9, "US shoe", "cm")
+	asserts.ErrorIs(err, services.ErrIncompatibleUnits)
+
+	_, err = services.ParseUnit("GM/s")
+	asserts.ErrorIs(err, services.ErrIncompatibleUnits)
+}
+
+func TestReadTables(t *testing.T) {
+	asserts := assert.New(t)
+
+	tables, err := services.ReadTables(strings.NewReader("# chest in centimeters\nchest,shirt-size,eu-shirt-size\n88,1/2,44\n96,1,\n104,2,52\n"))
+	asserts.NoError(err)
+	asserts.Equal(&services.Table{Sizes: []float64{0.5, 1, 2}, References: []float64{88, 96, 104}}, tables["shirt-size"])
+	asserts.Equal(&services.Table{Sizes: []float64{44, 52}, References: []float64{88, 104}}, tables["eu-shirt-size"])
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "❌ no rows", input: "chest,shirt-size\n"},
+		{name: "❌ no units", input: "chest\n88\n"},
+		{name: "❌ invalid size", input: "chest,shirt-size\n88,M\n"},
+		{name: "❌ sizes not increasing", input: "chest,shirt-size\n88,2\n96,1\n"},
+		{name: "❌ references not increasing", input: "chest,shirt-size\n96,1\n88,2\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := services.ReadTables(strings.NewReader(test.input))
+			asserts.Error(err)
+		})
+	}
+}
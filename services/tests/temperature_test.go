@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ngsalvo/roadmapsh-unit-converter/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemperatureScales(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		expected float64
+		err      error
+	}{
+		{name: "✅ boiling water in rankine", fromUnit: services.Celsius, toUnit: services.Rankine, value: 100, expected: 671.67},
+		{name: "✅ absolute zero in rankine", fromUnit: services.Kelvin, toUnit: services.Rankine, value: 0, expected: 0},
+		{name: "✅ rankine to fahrenheit", fromUnit: services.Rankine, toUnit: services.Fahrenheit, value: 491.67, expected: 32},
+		{name: "✅ boiling water in réaumur", fromUnit: services.Celsius, toUnit: services.Reaumur, value: 100, expected: 80},
+		{name: "✅ réaumur to celsius", fromUnit: services.Reaumur, toUnit: services.Celsius, value: 20, expected: 25},
+		{name: "✅ freezing water in rømer", fromUnit: services.Celsius, toUnit: services.Romer, value: 0, expected: 7.5},
+		{name: "✅ boiling water in rømer", fromUnit: services.Celsius, toUnit: services.Romer, value: 100, expected: 60},
+		{name: "✅ boiling water in delisle", fromUnit: services.Celsius, toUnit: services.Delisle, value: 100, expected: 0},
+		{name: "✅ freezing water in delisle", fromUnit: services.Celsius, toUnit: services.Delisle, value: 0, expected: 150},
+		{name: "✅ delisle to fahrenheit", fromUnit: services.Delisle, toUnit: services.Fahrenheit, value: 60, expected: 140},
+		{name: "✅ symbols", fromUnit: "°Ré", toUnit: "°Rø", value: 80, expected: 60},
+		{name: "❌ below absolute zero in rankine", fromUnit: services.Rankine, toUnit: services.Kelvin, value: -1, err: services.ErrOutOfDomain},
+		{name: "❌ beyond absolute zero in delisle", fromUnit: services.Delisle, toUnit: services.Kelvin, value: 600, err: services.ErrOutOfDomain},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(services.Temperature, test.fromUnit, test.toUnit, test.value)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestDelisleCountsDown(t *testing.T) {
+	asserts := assert.New(t)
+
+	_, err := services.Convert(services.Temperature, services.Delisle, services.Kelvin, 600)
+	asserts.EqualError(err, "value out of domain: 600 delisle is above the maximum of 559.725 delisle for temperature")
+}
+
+func TestTemperatureDifferences(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name     string
+		unitType services.UnitType
+		fromUnit services.Unit
+		toUnit   services.Unit
+		value    float64
+		opts     []services.ConvertOption
+		expected float64
+		err      error
+	}{
+		{name: "✅ celsius difference to fahrenheit difference", unitType: services.TemperatureDifference, fromUnit: services.DeltaCelsius, toUnit: services.DeltaFahrenheit, value: 10, expected: 18},
+		{name: "✅ fahrenheit difference to kelvin difference", unitType: services.TemperatureDifference, fromUnit: services.DeltaFahrenheit, toUnit: services.DeltaKelvin, value: 9, expected: 5},
+		{name: "✅ rankine difference to fahrenheit difference", unitType: services.TemperatureDifference, fromUnit: services.DeltaRankine, toUnit: services.DeltaFahrenheit, value: 7, expected: 7},
+		{name: "✅ negative differences", unitType: services.TemperatureDifference, fromUnit: services.DeltaCelsius, toUnit: services.DeltaFahrenheit, value: -300, expected: -540},
+		{name: "✅ interval symbols", unitType: services.TemperatureDifference, fromUnit: "C°", toUnit: "Δ°F", value: 1, expected: 1.8},
+		{
+			name:     "✅ interval of a temperature scale",
+			unitType: services.Temperature,
+			fromUnit: services.Celsius,
+			toUnit:   services.Fahrenheit,
+			value:    10,
+			opts:     []services.ConvertOption{services.WithInterval()},
+			expected: 18,
+		},
+		{
+			name:     "✅ negative interval below absolute zero",
+			unitType: services.Temperature,
+			fromUnit: services.Kelvin,
+			toUnit:   services.Celsius,
+			value:    -500,
+			opts:     []services.ConvertOption{services.WithInterval()},
+			expected: -500,
+		},
+		{
+			name:     "✅ interval on a descending scale",
+			unitType: services.Temperature,
+			fromUnit: services.Delisle,
+			toUnit:   services.Celsius,
+			value:    15,
+			opts:     []services.ConvertOption{services.WithInterval()},
+			expected: -10,
+		},
+		{
+			name:     "❌ interval of a length",
+			unitType: services.Length,
+			fromUnit: services.Meters,
+			toUnit:   services.Feet,
+			value:    1,
+			opts:     []services.ConvertOption{services.WithInterval()},
+			err:      services.ErrIncompatibleUnits,
+		},
+		{name: "❌ differences are not readings", unitType: services.TemperatureDifference, fromUnit: services.DeltaCelsius, toUnit: services.Fahrenheit, value: 1, err: services.ErrIncompatibleUnits},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value, test.opts...)
+			if test.err != nil {
+				asserts.ErrorIs(err, test.err)
+				return
+			}
+
+			asserts.NoError(err)
+			asserts.Equal(test.expected, actual, test.name)
+		})
+	}
+}
+
+func TestExactTemperatureDifferences(t *testing.T) {
+	asserts := assert.New(t)
+
+	actual, err := services.ConvertDecimal(services.TemperatureDifference, services.DeltaCelsius, services.DeltaFahrenheit, "1")
+	asserts.NoError(err)
+	asserts.True(actual.Exact)
+	asserts.Equal("9/5", actual.Value.RatString())
+
+	actual, err = services.ConvertDecimal(services.Temperature, services.Celsius, services.Fahrenheit, "-300", services.WithInterval())
+	asserts.NoError(err)
+	asserts.Equal("-540.00", actual.Decimal(2))
+}
+
+func TestTemperatureDifferencesInCompoundUnits(t *testing.T) {
+	asserts := assert.New(t)
+
+	// Specific heat of water
+	actual, err := services.ConvertUnits(4184, "J/kg·ΔK", "J/g·Δ°F")
+	asserts.NoError(err)
+	asserts.Equal(2.32, actual)
+}
@@ -160,6 +160,60 @@ func TestLengthConverter(t *testing.T) {
 			expected:  0.1,
 			expectErr: false,
 		},
+		{
+			name:      "✅ inches to centimeters",
+			unitType:  services.Length,
+			fromUnit:  services.Inches,
+			toUnit:    services.Centimeters,
+			value:     12,
+			expected:  30.48,
+			expectErr: false,
+		},
+		{
+			name:      "✅ millimeters to thou",
+			unitType:  services.Length,
+			fromUnit:  services.Millimeters,
+			toUnit:    services.Thou,
+			value:     1,
+			expected:  39.37,
+			expectErr: false,
+		},
+		{
+			name:      "✅ furlongs to chains",
+			unitType:  services.Length,
+			fromUnit:  services.Furlongs,
+			toUnit:    services.Chains,
+			value:     8,
+			expected:  80,
+			expectErr: false,
+		},
+		{
+			name:      "✅ fathoms to feet",
+			unitType:  services.Length,
+			fromUnit:  services.Fathoms,
+			toUnit:    services.Feet,
+			value:     10,
+			expected:  60,
+			expectErr: false,
+		},
+		{
+			name:      "✅ nautical miles to kilometers",
+			unitType:  services.Length,
+			fromUnit:  services.NauticalMiles,
+			toUnit:    services.Kilometers,
+			value:     10,
+			expected:  18.52,
+			expectErr: false,
+		},
+		{
+			name:      "✅ micrometers to millimeters",
+			unitType:  services.Length,
+			fromUnit:  services.Micrometers,
+			toUnit:    services.Millimeters,
+			value:     250,
+			expected:  0.25,
+			expectErr: false,
+		},
 		{
 			name:      "✅ kilometers to miles",
 			unitType:  services.Length,
@@ -370,7 +424,7 @@ func TestWeightConverter(t *testing.T) {
 			fromUnit:  services.Ounces,
 			toUnit:    services.Milligrams,
 			value:     10,
-			expected:  283495.2,
+			expected:  283495.23,
 			expectErr: false,
 		},
 		{
@@ -388,7 +442,7 @@ func TestWeightConverter(t *testing.T) {
 			fromUnit:  services.Pounds,
 			toUnit:    services.Milligrams,
 			value:     0.6,
-			expected:  272155.44,
+			expected:  272155.42,
 			expectErr: false,
 		},
 		{
@@ -428,3 +482,404 @@ func TestWeightConverter(t *testing.T) {
 		})
 	}
 }
+
+func TestVolumeConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name      string
+		unitType  services.UnitType
+		fromUnit  services.Unit
+		toUnit    services.Unit
+		value     float64
+		expected  float64
+		expectErr bool
+	}{
+		{
+			name:      "✅ liters to milliliters",
+			unitType:  services.Volume,
+			fromUnit:  services.Liters,
+			toUnit:    services.Milliliters,
+			value:     1.5,
+			expected:  1500,
+			expectErr: false,
+		},
+		{
+			name:      "✅ cubic meters to liters",
+			unitType:  services.Volume,
+			fromUnit:  services.CubicMeters,
+			toUnit:    services.Liters,
+			value:     2,
+			expected:  2000,
+			expectErr: false,
+		},
+		{
+			name:      "✅ cubic centimeters to milliliters",
+			unitType:  services.Volume,
+			fromUnit:  services.CubicCentimeters,
+			toUnit:    services.Milliliters,
+			value:     250,
+			expected:  250,
+			expectErr: false,
+		},
+		{
+			name:      "✅ cubic feet to cubic inches",
+			unitType:  services.Volume,
+			fromUnit:  services.CubicFeet,
+			toUnit:    services.CubicInches,
+			value:     1,
+			expected:  1728,
+			expectErr: false,
+		},
+		{
+			name:      "✅ us gallons to liters",
+			unitType:  services.Volume,
+			fromUnit:  services.USGallons,
+			toUnit:    services.Liters,
+			value:     1,
+			expected:  3.79,
+			expectErr: false,
+		},
+		{
+			name:      "✅ imperial gallons to liters",
+			unitType:  services.Volume,
+			fromUnit:  services.ImperialGallons,
+			toUnit:    services.Liters,
+			value:     1,
+			expected:  4.55,
+			expectErr: false,
+		},
+		{
+			name:      "✅ us gallons to cubic inches",
+			unitType:  services.Volume,
+			fromUnit:  services.USGallons,
+			toUnit:    services.CubicInches,
+			value:     1,
+			expected:  231,
+			expectErr: false,
+		},
+		{
+			name:      "✅ us cups to us fluid ounces",
+			unitType:  services.Volume,
+			fromUnit:  services.USCups,
+			toUnit:    services.USFluidOunces,
+			value:     2,
+			expected:  16,
+			expectErr: false,
+		},
+		{
+			name:      "✅ us tablespoons to us teaspoons",
+			unitType:  services.Volume,
+			fromUnit:  services.USTablespoons,
+			toUnit:    services.USTeaspoons,
+			value:     1,
+			expected:  3,
+			expectErr: false,
+		},
+		{
+			name:      "✅ imperial pints to imperial fluid ounces",
+			unitType:  services.Volume,
+			fromUnit:  services.ImperialPints,
+			toUnit:    services.ImperialFluidOunces,
+			value:     1,
+			expected:  20,
+			expectErr: false,
+		},
+		{
+			name:      "✅ imperial tablespoons to imperial teaspoons",
+			unitType:  services.Volume,
+			fromUnit:  services.ImperialTablespoons,
+			toUnit:    services.ImperialTeaspoons,
+			value:     2,
+			expected:  6,
+			expectErr: false,
+		},
+		{
+			name:      "✅ imperial gallons to us gallons",
+			unitType:  services.Volume,
+			fromUnit:  services.ImperialGallons,
+			toUnit:    services.USGallons,
+			value:     1,
+			expected:  1.2,
+			expectErr: false,
+		},
+		{
+			name:      "✅ us quarts to us pints",
+			unitType:  services.Volume,
+			fromUnit:  services.USQuarts,
+			toUnit:    services.USPints,
+			value:     1,
+			expected:  2,
+			expectErr: false,
+		},
+		{
+			name:      "❌ negative volume",
+			unitType:  services.Volume,
+			fromUnit:  services.Liters,
+			toUnit:    services.USGallons,
+			value:     -1,
+			expected:  0,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value)
+			asserts.Equal(test.expected, actual, test.name)
+			asserts.Equal(test.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAreaConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name      string
+		unitType  services.UnitType
+		fromUnit  services.Unit
+		toUnit    services.Unit
+		value     float64
+		expected  float64
+		expectErr bool
+	}{
+		{
+			name:      "✅ square meters to square centimeters",
+			unitType:  services.Area,
+			fromUnit:  services.SquareMeters,
+			toUnit:    services.SquareCentimeters,
+			value:     1.5,
+			expected:  15000,
+			expectErr: false,
+		},
+		{
+			name:      "✅ hectares to square meters",
+			unitType:  services.Area,
+			fromUnit:  services.Hectares,
+			toUnit:    services.SquareMeters,
+			value:     2,
+			expected:  20000,
+			expectErr: false,
+		},
+		{
+			name:      "✅ ares to square meters",
+			unitType:  services.Area,
+			fromUnit:  services.Ares,
+			toUnit:    services.SquareMeters,
+			value:     3,
+			expected:  300,
+			expectErr: false,
+		},
+		{
+			name:      "✅ square kilometers to hectares",
+			unitType:  services.Area,
+			fromUnit:  services.SquareKilometers,
+			toUnit:    services.Hectares,
+			value:     1,
+			expected:  100,
+			expectErr: false,
+		},
+		{
+			name:      "✅ square millimeters to square centimeters",
+			unitType:  services.Area,
+			fromUnit:  services.SquareMillimeters,
+			toUnit:    services.SquareCentimeters,
+			value:     250,
+			expected:  2.5,
+			expectErr: false,
+		},
+		{
+			name:      "✅ square feet to square inches",
+			unitType:  services.Area,
+			fromUnit:  services.SquareFeet,
+			toUnit:    services.SquareInches,
+			value:     1,
+			expected:  144,
+			expectErr: false,
+		},
+		{
+			name:      "✅ square yards to square feet",
+			unitType:  services.Area,
+			fromUnit:  services.SquareYards,
+			toUnit:    services.SquareFeet,
+			value:     1,
+			expected:  9,
+			expectErr: false,
+		},
+		{
+			name:      "✅ acres to square yards",
+			unitType:  services.Area,
+			fromUnit:  services.Acres,
+			toUnit:    services.SquareYards,
+			value:     1,
+			expected:  4840,
+			expectErr: false,
+		},
+		{
+			name:      "✅ square miles to acres",
+			unitType:  services.Area,
+			fromUnit:  services.SquareMiles,
+			toUnit:    services.Acres,
+			value:     1,
+			expected:  640,
+			expectErr: false,
+		},
+		{
+			name:      "✅ acres to hectares",
+			unitType:  services.Area,
+			fromUnit:  services.Acres,
+			toUnit:    services.Hectares,
+			value:     1,
+			expected:  0.4,
+			expectErr: false,
+		},
+		{
+			name:      "✅ square feet to square meters",
+			unitType:  services.Area,
+			fromUnit:  services.SquareFeet,
+			toUnit:    services.SquareMeters,
+			value:     100,
+			expected:  9.29,
+			expectErr: false,
+		},
+		{
+			name:      "❌ length unit",
+			unitType:  services.Area,
+			fromUnit:  services.Feet,
+			toUnit:    services.SquareFeet,
+			value:     1,
+			expected:  0,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value)
+			asserts.Equal(test.expected, actual, test.name)
+			asserts.Equal(test.expectErr, err != nil)
+		})
+	}
+}
+
+func TestSpeedConverter(t *testing.T) {
+	asserts := assert.New(t)
+
+	tests := []struct {
+		name      string
+		unitType  services.UnitType
+		fromUnit  services.Unit
+		toUnit    services.Unit
+		value     float64
+		expected  float64
+		expectErr bool
+	}{
+		{
+			name:      "✅ kilometers per hour to meters per second",
+			unitType:  services.Speed,
+			fromUnit:  services.KilometersPerHour,
+			toUnit:    services.MetersPerSecond,
+			value:     36,
+			expected:  10,
+			expectErr: false,
+		},
+		{
+			name:      "✅ miles per hour to kilometers per hour",
+			unitType:  services.Speed,
+			fromUnit:  services.MilesPerHour,
+			toUnit:    services.KilometersPerHour,
+			value:     60,
+			expected:  96.56,
+			expectErr: false,
+		},
+		{
+			name:      "✅ meters per second to feet per second",
+			unitType:  services.Speed,
+			fromUnit:  services.MetersPerSecond,
+			toUnit:    services.FeetPerSecond,
+			value:     10,
+			expected:  32.81,
+			expectErr: false,
+		},
+		{
+			name:      "✅ knots to kilometers per hour",
+			unitType:  services.Speed,
+			fromUnit:  services.Knots,
+			toUnit:    services.KilometersPerHour,
+			value:     10,
+			expected:  18.52,
+			expectErr: false,
+		},
+		{
+			name:      "✅ knots to miles per hour",
+			unitType:  services.Speed,
+			fromUnit:  services.Knots,
+			toUnit:    services.MilesPerHour,
+			value:     100,
+			expected:  115.08,
+			expectErr: false,
+		},
+		{
+			name:      "✅ mach to kilometers per hour",
+			unitType:  services.Speed,
+			fromUnit:  services.Mach,
+			toUnit:    services.KilometersPerHour,
+			value:     1,
+			expected:  1225.06,
+			expectErr: false,
+		},
+		{
+			name:      "✅ speed of light to kilometers per hour",
+			unitType:  services.Speed,
+			fromUnit:  services.SpeedOfLight,
+			toUnit:    services.KilometersPerHour,
+			value:     0.5,
+			expected:  539626424.4,
+			expectErr: false,
+		},
+		{
+			name:      "✅ negative velocity",
+			unitType:  services.Speed,
+			fromUnit:  services.MetersPerSecond,
+			toUnit:    services.KilometersPerHour,
+			value:     -10,
+			expected:  -36,
+			expectErr: false,
+		},
+		{
+			name:      "❌ distance is not speed",
+			unitType:  services.Speed,
+			fromUnit:  services.Miles,
+			toUnit:    services.MilesPerHour,
+			value:     1,
+			expected:  0,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := services.Convert(test.unitType, test.fromUnit, test.toUnit, test.value)
+			asserts.Equal(test.expected, actual, test.name)
+			asserts.Equal(test.expectErr, err != nil)
+		})
+	}
+}
+
+func TestRegistryConvertsEveryPair(t *testing.T) {
+	asserts := assert.New(t)
+
+	for unitType := range services.Registry {
+		units := services.Units(unitType)
+		for _, fromUnit := range units {
+			for _, toUnit := range units {
+				t.Run(fmt.Sprintf("✅ %s %s to %s", unitType, fromUnit, toUnit), func(t *testing.T) {
+					conversion, err := services.Converter(unitType, fromUnit, toUnit)
+					asserts.NoError(err)
+					asserts.NotNil(conversion)
+				})
+			}
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package services
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
 )
 
 // ConverterFunc is a function that converts one value to another
@@ -19,6 +21,23 @@ const (
 	Temperature UnitType = "temperature"
 	Length      UnitType = "length"
 	Weight      UnitType = "weight"
+	Time        UnitType = "time"
+	Force       UnitType = "force"
+	Energy      UnitType = "energy"
+	Volume      UnitType = "volume"
+	Area        UnitType = "area"
+	Speed       UnitType = "speed"
+	Data        UnitType = "data"
+	DataRate    UnitType = "data-rate"
+	Pressure    UnitType = "pressure"
+	Power       UnitType = "power"
+	Angle       UnitType = "angle"
+	FuelEconomy UnitType = "fuel-economy"
+	Currency    UnitType = "currency"
+	ShoeSize    UnitType = "shoe-size"
+	RingSize    UnitType = "ring-size"
+
+	TemperatureDifference UnitType = "temperature-difference"
 )
 
 // Supported units for Temperature
@@ -26,15 +45,51 @@ const (
 	Celsius    Unit = "celsius"
 	Fahrenheit Unit = "fahrenheit"
 	Kelvin     Unit = "kelvin"
+	Rankine    Unit = "rankine"
+	Reaumur    Unit = "reaumur"
+	Romer      Unit = "romer"
+	Delisle    Unit = "delisle"
+
+	// GasMark is read from a table of oven temperatures, see Tabulated
+	GasMark Unit = "gas-mark"
+)
+
+// Supported units for TemperatureDifference: a change of 10 °C is a change of
+// 18 °F, while a reading of 10 °C is a reading of 50 °F
+const (
+	DeltaKelvin     Unit = "delta-kelvin"
+	DeltaCelsius    Unit = "delta-celsius"
+	DeltaFahrenheit Unit = "delta-fahrenheit"
+	DeltaRankine    Unit = "delta-rankine"
 )
 
 // Supported units for Length
 const (
-	Meters     Unit = "meters"
-	Kilometers Unit = "kilometers"
-	Feet       Unit = "feet"
-	Yards      Unit = "yards"
-	Miles      Unit = "miles"
+	Micrometers   Unit = "micrometers"
+	Millimeters   Unit = "millimeters"
+	Centimeters   Unit = "centimeters"
+	Meters        Unit = "meters"
+	Kilometers    Unit = "kilometers"
+	Thou          Unit = "thou"
+	Inches        Unit = "inches"
+	Feet          Unit = "feet"
+	USSurveyFeet  Unit = "us-survey-feet"
+	Yards         Unit = "yards"
+	Fathoms       Unit = "fathoms"
+	Chains        Unit = "chains"
+	Furlongs      Unit = "furlongs"
+	Miles         Unit = "miles"
+	NauticalMiles Unit = "nautical-miles"
+
+	// Typographic units. Points and picas are fixed; screen units depend on
+	// the Parameter values of the conversion.
+	Points                   Unit = "points"
+	Picas                    Unit = "picas"
+	Pixels                   Unit = "pixels"
+	Ems                      Unit = "ems"
+	Rems                     Unit = "rems"
+	DensityIndependentPixels Unit = "density-independent-pixels"
+	ScaleIndependentPixels   Unit = "scale-independent-pixels"
 )
 
 // Supported units for Weight
@@ -42,8 +97,308 @@ const (
 	Milligrams Unit = "milligrams"
 	Grams      Unit = "grams"
 	Kilograms  Unit = "kilograms"
+	Tonnes     Unit = "tonnes"
 	Ounces     Unit = "ounces"
 	Pounds     Unit = "pounds"
+	ShortTons  Unit = "short-tons"
+	LongTons   Unit = "long-tons"
+)
+
+// Supported units for Time. Months and years are average lengths, see
+// UnitDefinition.Approximate.
+const (
+	Nanoseconds     Unit = "nanoseconds"
+	Microseconds    Unit = "microseconds"
+	Milliseconds    Unit = "milliseconds"
+	Seconds         Unit = "seconds"
+	Minutes         Unit = "minutes"
+	Hours           Unit = "hours"
+	Days            Unit = "days"
+	Weeks           Unit = "weeks"
+	GregorianMonths Unit = "gregorian-months"
+	JulianMonths    Unit = "julian-months"
+	GregorianYears  Unit = "gregorian-years"
+	JulianYears     Unit = "julian-years"
+)
+
+// Supported units for Force
+const (
+	Newtons     Unit = "newtons"
+	PoundsForce Unit = "pounds-force"
+)
+
+// Supported units for Energy
+const (
+	Electronvolts       Unit = "electronvolts"
+	Joules              Unit = "joules"
+	Kilojoules          Unit = "kilojoules"
+	Megajoules          Unit = "megajoules"
+	FootPoundsForce     Unit = "foot-pounds-force"
+	Calories            Unit = "calories"
+	Kilocalories        Unit = "kilocalories"
+	BritishThermalUnits Unit = "british-thermal-units"
+	WattHours           Unit = "watt-hours"
+	KilowattHours       Unit = "kilowatt-hours"
+	Therms              Unit = "therms"
+)
+
+// Supported units for Power
+const (
+	Watts               Unit = "watts"
+	Kilowatts           Unit = "kilowatts"
+	Megawatts           Unit = "megawatts"
+	BTUsPerHour         Unit = "btus-per-hour"
+	MetricHorsepower    Unit = "metric-horsepower"
+	Horsepower          Unit = "horsepower"
+	TonsOfRefrigeration Unit = "tons-of-refrigeration"
+)
+
+// Supported units for Volume. US customary and Imperial units share names,
+// so they are kept apart by their prefix.
+const (
+	CubicMeters      Unit = "cubic-meters"
+	Liters           Unit = "liters"
+	Milliliters      Unit = "milliliters"
+	CubicCentimeters Unit = "cubic-centimeters"
+	CubicInches      Unit = "cubic-inches"
+	CubicFeet        Unit = "cubic-feet"
+
+	USTeaspoons   Unit = "us-teaspoons"
+	USTablespoons Unit = "us-tablespoons"
+	USFluidOunces Unit = "us-fluid-ounces"
+	USCups        Unit = "us-cups"
+	USPints       Unit = "us-pints"
+	USQuarts      Unit = "us-quarts"
+	USGallons     Unit = "us-gallons"
+
+	ImperialTeaspoons   Unit = "imperial-teaspoons"
+	ImperialTablespoons Unit = "imperial-tablespoons"
+	ImperialFluidOunces Unit = "imperial-fluid-ounces"
+	ImperialCups        Unit = "imperial-cups"
+	ImperialPints       Unit = "imperial-pints"
+	ImperialQuarts      Unit = "imperial-quarts"
+	ImperialGallons     Unit = "imperial-gallons"
+)
+
+// Supported units for Area
+const (
+	SquareMillimeters Unit = "square-millimeters"
+	SquareCentimeters Unit = "square-centimeters"
+	SquareMeters      Unit = "square-meters"
+	Ares              Unit = "ares"
+	Hectares          Unit = "hectares"
+	SquareKilometers  Unit = "square-kilometers"
+	SquareInches      Unit = "square-inches"
+	SquareFeet        Unit = "square-feet"
+	SquareYards       Unit = "square-yards"
+	Acres             Unit = "acres"
+	SquareMiles       Unit = "square-miles"
+)
+
+// Supported units for Speed
+const (
+	MetersPerSecond   Unit = "meters-per-second"
+	KilometersPerHour Unit = "kilometers-per-hour"
+	FeetPerSecond     Unit = "feet-per-second"
+	MilesPerHour      Unit = "miles-per-hour"
+	Knots             Unit = "knots"
+	Mach              Unit = "mach"
+	SpeedOfLight      Unit = "speed-of-light"
+)
+
+// Supported units for Data. SI prefixes are decimal (1 kB = 1000 B) and IEC
+// prefixes binary (1 KiB = 1024 B); the customary "KB" could be either, so
+// Lookup reports it as ambiguous rather than guessing.
+const (
+	Bits      Unit = "bits"
+	Bytes     Unit = "bytes"
+	Kilobits  Unit = "kilobits"
+	Megabits  Unit = "megabits"
+	Gigabits  Unit = "gigabits"
+	Kilobytes Unit = "kilobytes"
+	Kibibytes Unit = "kibibytes"
+	Megabytes Unit = "megabytes"
+	Mebibytes Unit = "mebibytes"
+	Gigabytes Unit = "gigabytes"
+	Gibibytes Unit = "gibibytes"
+	Terabytes Unit = "terabytes"
+	Tebibytes Unit = "tebibytes"
+	Petabytes Unit = "petabytes"
+	Pebibytes Unit = "pebibytes"
+)
+
+// Supported units for DataRate
+const (
+	BitsPerSecond      Unit = "bits-per-second"
+	KilobitsPerSecond  Unit = "kilobits-per-second"
+	MegabitsPerSecond  Unit = "megabits-per-second"
+	GigabitsPerSecond  Unit = "gigabits-per-second"
+	BytesPerSecond     Unit = "bytes-per-second"
+	MegabytesPerSecond Unit = "megabytes-per-second"
+	MebibytesPerSecond Unit = "mebibytes-per-second"
+)
+
+// Supported units for Pressure
+const (
+	Pascals              Unit = "pascals"
+	Hectopascals         Unit = "hectopascals"
+	Kilopascals          Unit = "kilopascals"
+	Megapascals          Unit = "megapascals"
+	Bars                 Unit = "bars"
+	Millibars            Unit = "millibars"
+	Atmospheres          Unit = "atmospheres"
+	PoundsPerSquareInch  Unit = "pounds-per-square-inch"
+	MillimetersOfMercury Unit = "millimeters-of-mercury"
+	InchesOfMercury      Unit = "inches-of-mercury"
+	Torr                 Unit = "torr"
+	CentimetersOfWater   Unit = "centimeters-of-water"
+)
+
+// Supported units for Angle
+const (
+	Arcseconds   Unit = "arcseconds"
+	Arcminutes   Unit = "arcminutes"
+	Milliradians Unit = "milliradians"
+	Gradians     Unit = "gradians"
+	Degrees      Unit = "degrees"
+	Radians      Unit = "radians"
+	Turns        Unit = "turns"
+)
+
+// Supported units for FuelEconomy. Consumption units such as L/100km are
+// inversely proportional to distance per volume, see Reciprocal.
+const (
+	KilometersPerLiter     Unit = "kilometers-per-liter"
+	LitersPer100Kilometers Unit = "liters-per-100-kilometers"
+	MilesPerUSGallon       Unit = "miles-per-us-gallon"
+	MilesPerImperialGallon Unit = "miles-per-imperial-gallon"
+)
+
+// Supported units for ShoeSize. Mondopoint is the length of the foot in
+// millimeters; the other sizes are read from a table, see Tabulated.
+const (
+	Mondopoint Unit = "mondopoint"
+	USShoeSize Unit = "us-shoe-size"
+	UKShoeSize Unit = "uk-shoe-size"
+	EUShoeSize Unit = "eu-shoe-size"
+	JPShoeSize Unit = "jp-shoe-size"
+)
+
+// Supported units for RingSize. ISO sizes are the inner circumference of the
+// ring in millimeters; the other sizes are read from a table, see Tabulated.
+const (
+	ISORingSize Unit = "iso-ring-size"
+	USRingSize  Unit = "us-ring-size"
+	JPRingSize  Unit = "jp-ring-size"
+)
+
+// Exact definitions of the units against the base unit of their type
+const (
+	// Kelvin at 0 °C and at 0 °F, by definition of the Celsius and Fahrenheit scales
+	kelvinAtZeroCelsius    = 273.15
+	kelvinPerFahrenheit    = 5.0 / 9
+	kelvinAtZeroFahrenheit = 459.67 * kelvinPerFahrenheit
+	kelvinPerRankine       = "5/9" // Rankine degrees are Fahrenheit degrees from absolute zero
+	// Réaumur puts water boiling at 80 °Ré, Rømer freezing at 7.5 °Rø and boiling
+	// at 60 °Rø, and Delisle counts down from boiling at 0 °De to freezing at 150 °De
+	kelvinPerReaumur    = 5.0 / 4
+	kelvinPerRomer      = 40.0 / 21
+	kelvinAtZeroRomer   = kelvinAtZeroCelsius - 7.5*kelvinPerRomer
+	kelvinPerDelisle    = -2.0 / 3
+	kelvinAtZeroDelisle = kelvinAtZeroCelsius + 100
+
+	// International yard and pound agreement (1959), written as exact rationals
+	metersPerThou    = "0.0000254"  // 1/1000 inch
+	metersPerInch    = "0.0254"     // 1/12 foot
+	metersPerPoint   = "127/360000" // 1/72 inch, the desktop publishing point
+	metersPerPica    = "127/30000"  // 12 points
+	metersPerFoot    = "0.3048"
+	metersPerYard    = "0.9144"   // 3 feet
+	metersPerFathom  = "1.8288"   // 2 yards
+	metersPerChain   = "20.1168"  // 22 yards
+	metersPerFurlong = "201.168"  // 10 chains
+	metersPerMile    = "1609.344" // 1760 yards
+	gramsPerPound    = "453.59237"
+	gramsPerOunce    = "28.349523125" // 1/16 pound
+	gramsPerShortTon = "907184.74"    // 2000 pounds
+	gramsPerLongTon  = "1016046.9088" // 2240 pounds
+
+	// US survey foot (Mendenhall Order, 1893), kept for legacy survey data
+	metersPerUSSurveyFoot = "1200/3937"
+
+	// International nautical mile (1929)
+	metersPerNauticalMile = "1852"
+
+	bitsPerByte     = "8"
+	bitsPerKilobyte = "8000"
+	bitsPerKibibyte = "8192"
+
+	// Standard atmosphere (CGPM 1954), and the conventional manometric units
+	// defined from standard gravity
+	pascalsPerAtmosphere          = "101325"
+	pascalsPerTorr                = "101325/760"
+	pascalsPerMillimeterOfMercury = "133.322387415"
+	pascalsPerCentimeterOfWater   = "98.0665"
+
+	degreesPerTurn    = "360"
+	degreesPerGradian = "0.9" // 1/400 turn
+
+	secondsPerMinute = "60"
+	secondsPerHour   = "3600"
+	secondsPerDay    = "86400"
+	secondsPerWeek   = "604800"
+
+	// Average years of the Julian (365.25 days) and Gregorian (365.2425 days)
+	// calendars, and their twelfths
+	secondsPerJulianYear     = "31557600"
+	secondsPerGregorianYear  = "31556952"
+	secondsPerJulianMonth    = "2629800"
+	secondsPerGregorianMonth = "2629746"
+
+	// Speed of light in vacuum, exact since the 1983 definition of the meter
+	metersPerSecondPerSpeedOfLight = "299792458"
+
+	// Speed of sound in the ISA standard atmosphere at sea level (15 °C). Mach
+	// depends on the air temperature, so this is a convention, not an exact factor.
+	metersPerSecondPerMach = 340.294
+
+	// US customary liquid measures, from the 231 cubic inch gallon
+	cubicMetersPerUSGallon     = "0.003785411784"
+	cubicMetersPerUSQuart      = "0.000946352946"      // 1/4 gallon
+	cubicMetersPerUSPint       = "0.000473176473"      // 1/8 gallon
+	cubicMetersPerUSCup        = "0.0002365882365"     // 1/16 gallon
+	cubicMetersPerUSFluidOunce = "0.0000295735295625"  // 1/128 gallon
+	cubicMetersPerUSTablespoon = "0.00001478676478125" // 1/2 fluid ounce
+	cubicMetersPerUSTeaspoon   = "0.00000492892159375" // 1/3 tablespoon
+
+	// Imperial measures, from the 4.54609 liter gallon (Weights and Measures Act 1985)
+	cubicMetersPerImperialGallon     = "0.00454609"
+	cubicMetersPerImperialQuart      = "0.0011365225"       // 1/4 gallon
+	cubicMetersPerImperialPint       = "0.00056826125"      // 1/8 gallon
+	cubicMetersPerImperialCup        = "0.000284130625"     // 1/2 pint
+	cubicMetersPerImperialFluidOunce = "0.0000284130625"    // 1/160 gallon
+	cubicMetersPerImperialTablespoon = "0.0000177581640625" // 5/8 fluid ounce
+	cubicMetersPerImperialTeaspoon   = "454609/76800000000" // 1/3 tablespoon
+
+	// Standard gravity (CGPM 1901) times the international pound
+	newtonsPerPoundForce = "4.4482216152605"
+	// Standard gravity times one kilogram
+	newtonsPerKilogramForce = "9.80665"
+
+	// Thermochemical calorie, the calorie of nutrition labels
+	joulesPerCalorie = "4.184"
+	// International Table BTU, 1 IT calorie per gram per °C in pounds and °F
+	joulesPerBTU = "1055.05585262"
+	// US therm, 100000 BTU at 59 °F. The EC therm of 100000 IT BTU is 105505585.262 J.
+	joulesPerTherm = "105480400"
+	// Elementary charge times one volt, exact since the 2019 SI
+	joulesPerElectronvolt = "1.602176634e-19"
+
+	// Mechanical horsepower is 550 ft·lbf/s, metric horsepower 75 kgf·m/s
+	footPoundsForcePerSecondPerHorsepower           = "550"
+	kilogramForceMetersPerSecondPerMetricHorsepower = "75"
+	// A ton of refrigeration melts a short ton of ice a day: 12000 BTU/h
+	btusPerHourPerTonOfRefrigeration = "12000"
 )
 
 // Unit to String
@@ -51,160 +406,475 @@ func (u Unit) String() string {
 	return string(u)
 }
 
-// ConversionTable holds the conversion functions for different unit types
-var ConversionTable = map[UnitType]map[Unit]map[Unit]ConverterFunc{
+// UnitDefinition declares how a unit relates to the base unit of its type.
+// A value expressed in the unit maps to the base unit as value*Factor + Offset.
+type UnitDefinition struct {
+	Factor float64
+	Offset float64
+
+	// Symbol is the conventional abbreviation of the unit ("m", "°F")
+	Symbol string
+	// Alternates are other spellings of Symbol ("l" for "L", "bps" for "bit/s");
+	// unlike aliases they take the symbol of a prefix
+	Alternates []string
+	// Aliases are other names Lookup resolves to the unit ("metre", "lbs", "#")
+	Aliases []string
+	// Prefixes are the prefixes the unit accepts, see Definition
+	Prefixes PrefixSet
+	// Approximate marks units standing for a quantity that varies, such as a
+	// month or Mach; their factor is a conventional average
+	Approximate bool
+	// Inverse marks units inversely proportional to the base unit, see Reciprocal
+	Inverse bool
+	// Parameters holds the exponents of the context parameters the size of the
+	// unit depends on, see Scaled
+	Parameters map[Parameter]int
+	// Table maps the sizes of table-backed units to values of the unit that
+	// Factor and Offset define, see Tabulated
+	Table *Table
+
+	// exact is the factor as an exact rational, when the unit is defined by one
+	exact *big.Rat
+}
+
+// Linear defines a unit that is a plain multiple of the base unit
+func Linear(factor float64) UnitDefinition {
+	return UnitDefinition{Factor: factor}
+}
+
+// Rational defines a unit that is an exact rational multiple of the base unit.
+// The factor is written as a decimal ("0.3048") or a fraction ("1200/3937").
+// It panics if the factor cannot be parsed, since definitions are fixed at init.
+func Rational(factor string) UnitDefinition {
+	exact, ok := new(big.Rat).SetString(factor)
+	if !ok {
+		panic(fmt.Sprintf("services: invalid rational factor %q", factor))
+	}
+
+	float, _ := exact.Float64()
+	return UnitDefinition{Factor: float, exact: exact}
+}
+
+// Product defines a unit as the exact product of factors, so areas and volumes
+// follow their length units: square feet are Product(metersPerFoot, metersPerFoot).
+func Product(factors ...string) UnitDefinition {
+	exact := big.NewRat(1, 1)
+	for _, factor := range factors {
+		exact.Mul(exact, Rational(factor).exact)
+	}
+
+	float, _ := exact.Float64()
+	return UnitDefinition{Factor: float, exact: exact}
+}
+
+// Per returns the definition divided by an exact factor, e.g. miles per hour
+// is Rational(metersPerMile).Per(secondsPerHour). d must be exact.
+func (d UnitDefinition) Per(factor string) UnitDefinition {
+	exact := new(big.Rat).Quo(d.exact, Rational(factor).exact)
+	float, _ := exact.Float64()
+	return UnitDefinition{Factor: float, exact: exact}
+}
+
+// WithAlternates returns a copy of the definition with other spellings of its symbol
+func (d UnitDefinition) WithAlternates(alternates ...string) UnitDefinition {
+	d.Alternates = alternates
+	return d
+}
+
+// Approximated returns a copy of the definition marked as approximate
+func (d UnitDefinition) Approximated() UnitDefinition {
+	d.Approximate = true
+	return d
+}
+
+// Reciprocal defines a unit inversely proportional to the base unit: a value v
+// stands for factor/v base units, the way 5 L/100km is 100/5 km/L
+func Reciprocal(factor string) UnitDefinition {
+	d := Rational(factor)
+	d.Inverse = true
+	return d
+}
+
+// Affine defines a unit that is scaled and shifted from the base unit (e.g. Celsius from Kelvin)
+func Affine(scale, offset float64) UnitDefinition {
+	return UnitDefinition{Factor: scale, Offset: offset}
+}
+
+// Named returns a copy of the definition with the given symbol and aliases
+func (d UnitDefinition) Named(symbol string, aliases ...string) UnitDefinition {
+	d.Symbol = symbol
+	d.Aliases = aliases
+	return d
+}
+
+// ToBase converts a value in this unit to the base unit of its type
+func (d UnitDefinition) ToBase(value float64) float64 {
+	if d.Table != nil {
+		return d.linear().ToBase(d.Table.reference(value))
+	}
+	if d.Inverse {
+		return d.Factor / value
+	}
+	return value*d.Factor + d.Offset
+}
+
+// FromBase converts a value in the base unit of its type to this unit
+func (d UnitDefinition) FromBase(value float64) float64 {
+	if d.Table != nil {
+		return d.Table.size(d.linear().FromBase(value))
+	}
+	if d.Inverse {
+		return d.Factor / value
+	}
+	return (value - d.Offset) / d.Factor
+}
+
+// Registry holds the definition of every supported unit, grouped by unit type.
+// Each unit is defined once against the base unit of its type:
+// Kelvin for Temperature, DeltaKelvin for TemperatureDifference, Meters for Length, Grams for Weight, Seconds for Time,
+// Newtons for Force, Joules for Energy, CubicMeters for Volume, SquareMeters
+// for Area, MetersPerSecond for Speed, Bits for Data, BitsPerSecond for DataRate
+// Pascals for Pressure, Watts for Power, Degrees for Angle,
+// KilometersPerLiter for FuelEconomy, the base currency of the rates in use
+// for Currency, Mondopoint for ShoeSize and ISORingSize for RingSize.
+// Prefixed units (kilometers, milliliters, ...) are not listed; they are derived
+// from the units that accept prefixes, see Definition, and derived again
+// whenever units are added to a type or removed from it.
+var Registry = map[UnitType]map[Unit]UnitDefinition{
 	Temperature: {
-		Celsius: {
-			Fahrenheit: celsiusToFahrenheit,
-			Kelvin:     celsiusToKelvin,
-		},
-		Fahrenheit: {
-			Celsius: fahrenheitToCelsius,
-			Kelvin:  fahrenheitToKelvin,
-		},
-		Kelvin: {
-			Celsius:    kelvinToCelsius,
-			Fahrenheit: kelvinToFahrenheit,
-		},
+		Kelvin:     Rational("1").Named("K", "kelvins", "degK").WithPrefixes(SIPrefixed),
+		Celsius:    Affine(1, kelvinAtZeroCelsius).Named("°C", "C", "degC", "℃", "centigrade"),
+		Fahrenheit: Affine(kelvinPerFahrenheit, kelvinAtZeroFahrenheit).Named("°F", "F", "degF", "℉"),
+		Rankine:    Rational(kelvinPerRankine).Named("°R", "R", "degR", "°Ra"),
+		Reaumur:    Affine(kelvinPerReaumur, kelvinAtZeroCelsius).Named("°Ré", "°Re", "Ré", "degRe", "réaumur"),
+		Romer:      Affine(kelvinPerRomer, kelvinAtZeroRomer).Named("°Rø", "°Ro", "Rø", "degRo", "rømer"),
+		Delisle:    Affine(kelvinPerDelisle, kelvinAtZeroDelisle).Named("°De", "De", "degDe", "°D"),
+		GasMark:    Tabulated(gasMarks[GasMark], Affine(kelvinPerFahrenheit, kelvinAtZeroFahrenheit)).Named("GM", "gas mark", "gas marks", "regulo"),
+	},
+
+	TemperatureDifference: {
+		DeltaKelvin:     Rational("1").Named("ΔK", "delta K", "kelvin difference"),
+		DeltaCelsius:    Rational("1").Named("Δ°C", "ΔC", "C°", "delta °C", "celsius difference"),
+		DeltaFahrenheit: Rational(kelvinPerRankine).Named("Δ°F", "ΔF", "F°", "delta °F", "fahrenheit difference"),
+		DeltaRankine:    Rational(kelvinPerRankine).Named("Δ°R", "ΔR", "R°", "delta °R", "rankine difference"),
 	},
 
 	Length: {
-		Meters: {
-			Kilometers: metersToKilometers,
-			Feet:       metersToFeet,
-			Yards:      metersToYards,
-			Miles:      metersToMiles,
-		},
-		Kilometers: {
-			Meters: kilometersToMeters,
-			Feet:   kilometersToFeet,
-			Yards:  kilometersToYards,
-			Miles:  kilometersToMiles,
-		},
-		Feet: {
-			Meters:     feetToMeters,
-			Kilometers: feetToKilometers,
-			Yards:      feetToYards,
-			Miles:      feetToMiles,
-		},
-		Yards: {
-			Meters:     yardsToMeters,
-			Kilometers: yardsToKilometers,
-			Feet:       yardsToFeet,
-			Miles:      yardsToMiles,
-		},
-		Miles: {
-			Meters:     milesToMeters,
-			Kilometers: milesToKilometers,
-			Feet:       milesToFeet,
-			Yards:      milesToYards,
-		},
+		Meters:        Rational("1").Named("m", "meter", "metre", "metres").WithPrefixes(SIPrefixed),
+		Thou:          Rational(metersPerThou).Named("th", "mil", "mils"),
+		Inches:        Rational(metersPerInch).Named("in", "inch", `"`, "″"),
+		Feet:          Rational(metersPerFoot).Named("ft", "foot", "'", "′"),
+		USSurveyFeet:  Rational(metersPerUSSurveyFoot).Named("ftUS", "survey foot", "survey feet", "US survey foot", "US survey feet"),
+		Yards:         Rational(metersPerYard).Named("yd", "yard", "yds"),
+		Fathoms:       Rational(metersPerFathom).Named("ftm", "fathom"),
+		Chains:        Rational(metersPerChain).Named("ch", "chain"),
+		Furlongs:      Rational(metersPerFurlong).Named("fur", "furlong"),
+		Miles:         Rational(metersPerMile).Named("mi", "mile"),
+		NauticalMiles: Rational(metersPerNauticalMile).Named("nmi", "NM", "nautical mile", "nautical miles"),
+		Points:        Rational(metersPerPoint).Named("pt", "point"),
+		Picas:         Rational(metersPerPica).Named("pc", "pica"),
+		Pixels:        Rational(metersPerInch).Scaled(DPI, -1).Named("px", "pixel"),
+		Ems:           Rational(metersPerInch).Scaled(FontSize, 1).Scaled(DPI, -1).Named("em"),
+		Rems:          Rational(metersPerInch).Scaled(RootFontSize, 1).Scaled(DPI, -1).Named("rem"),
+		// Android dp are a 160th of an inch on a screen whose density matches its dpi
+		DensityIndependentPixels: Rational(metersPerInch).Scaled(Density, 1).Scaled(DPI, -1).Named("dp", "dip"),
+		ScaleIndependentPixels:   Rational(metersPerInch).Scaled(FontScale, 1).Scaled(Density, 1).Scaled(DPI, -1).Named("sp", "sip"),
 	},
 
 	Weight: {
-		Milligrams: {
-			Grams:     milligramsToGrams,
-			Kilograms: milligramsToKilograms,
-			Ounces:    milligramsToOunces,
-			Pounds:    milligramsToPounds,
-		},
-		Grams: {
-			Milligrams: gramsToMilligrams,
-			Kilograms:  gramsToKilograms,
-			Ounces:     gramsToOunces,
-			Pounds:     gramsToPounds,
-		},
-		Kilograms: {
-			Milligrams: kilogramsToMilligrams,
-			Grams:      kilogramsToGrams,
-			Ounces:     kilogramsToOunces,
-			Pounds:     kilogramsToPounds,
-		},
-		Ounces: {
-			Milligrams: ouncesToMilligrams,
-			Grams:      ouncesToGrams,
-			Kilograms:  ouncesToKilograms,
-			Pounds:     ouncesToPounds,
-		},
-		Pounds: {
-			Milligrams: poundsToMilligrams,
-			Grams:      poundsToGrams,
-			Kilograms:  poundsToKilograms,
-			Ounces:     poundsToOunces,
-		},
-	},
-}
-
-// Convert performs a conversion between two units of the same type
-func Convert(unitType UnitType, fromUnit, toUnit Unit, value float64) (float64, error) {
-	if fromUnit == toUnit {
-		return value, nil
-	}
-
-	conversion, ok := ConversionTable[unitType][fromUnit][toUnit]
-	if !ok {
-		return 0, fmt.Errorf("conversion from %q to %q not supported", fromUnit, toUnit)
-	}
-
-	return math.Round(conversion(value)*100) / 100, nil
-}
-
-// Temperature conversion functions
-func celsiusToFahrenheit(c float64) float64 { return c*9/5 + 32 }
-func celsiusToKelvin(c float64) float64     { return c + 273.15 }
-func fahrenheitToCelsius(f float64) float64 { return (f - 32) * 5 / 9 }
-func fahrenheitToKelvin(f float64) float64  { return (f + 459.67) * 5 / 9 }
-func kelvinToCelsius(k float64) float64     { return k - 273.15 }
-func kelvinToFahrenheit(k float64) float64  { return (k * 9 / 5) - 459.67 }
-
-// Length conversion functions
-func metersToKilometers(m float64) float64 { return m / 1000 }
-func metersToFeet(m float64) float64       { return m * 3.28084 }
-func metersToYards(m float64) float64      { return m * 1.09361 }
-func metersToMiles(m float64) float64      { return m * 0.000621371 }
-
-func kilometersToMeters(k float64) float64 { return k * 1000 }
-func kilometersToFeet(k float64) float64   { return k * 3280.84 }
-func kilometersToYards(k float64) float64  { return k * 1093.61 }
-func kilometersToMiles(k float64) float64  { return k * 0.621371 }
-
-func feetToMeters(f float64) float64     { return f / 3.28084 }
-func feetToKilometers(f float64) float64 { return f / 3280.84 }
-func feetToYards(f float64) float64      { return f / 3 }
-func feetToMiles(f float64) float64      { return f / 5280 }
-
-func yardsToMeters(y float64) float64     { return y / 1.09361 }
-func yardsToKilometers(y float64) float64 { return y / 1093.61 }
-func yardsToFeet(y float64) float64       { return y * 3 }
-func yardsToMiles(y float64) float64      { return y / 1760 }
-
-func milesToMeters(m float64) float64     { return m * 1609.34 }
-func milesToKilometers(m float64) float64 { return m * 1.60934 }
-func milesToFeet(m float64) float64       { return m * 5280 }
-func milesToYards(m float64) float64      { return m * 1760 }
-
-// Weight conversion functions
-func milligramsToGrams(mg float64) float64     { return mg / 1000 }
-func milligramsToKilograms(mg float64) float64 { return mg / 1000000 }
-func milligramsToOunces(mg float64) float64    { return mg * 0.000035274 }
-func milligramsToPounds(mg float64) float64    { return mg * 0.00000220462 }
-
-func gramsToMilligrams(g float64) float64 { return g * 1000 }
-func gramsToKilograms(g float64) float64  { return g / 1000 }
-func gramsToOunces(g float64) float64     { return g * 0.035274 }
-func gramsToPounds(g float64) float64     { return g * 0.00220462 }
-
-func kilogramsToMilligrams(kg float64) float64 { return kg * 1000000 }
-func kilogramsToGrams(kg float64) float64      { return kg * 1000 }
-func kilogramsToOunces(kg float64) float64     { return kg * 35.274 }
-func kilogramsToPounds(kg float64) float64     { return kg * 2.20462 }
-
-func ouncesToMilligrams(oz float64) float64 { return oz * 28349.52 }
-func ouncesToGrams(oz float64) float64      { return oz * 28.3495 }
-func ouncesToKilograms(oz float64) float64  { return oz * 0.02835 }
-func ouncesToPounds(oz float64) float64     { return oz * 0.0625 }
-
-func poundsToMilligrams(lb float64) float64 { return lb * 453592.4 }
-func poundsToGrams(lb float64) float64      { return lb * 453.5924 }
-func poundsToKilograms(lb float64) float64  { return lb * 0.453592 }
-func poundsToOunces(lb float64) float64     { return lb * 16 }
+		Grams:     Rational("1").Named("g", "gram", "gramme").WithPrefixes(SIPrefixed),
+		Tonnes:    Rational("1000000").Named("t", "tonne", "metric ton", "metric tons"),
+		Ounces:    Rational(gramsPerOunce).Named("oz", "ounce"),
+		Pounds:    Rational(gramsPerPound).Named("lb", "lbs", "#", "pound"),
+		ShortTons: Rational(gramsPerShortTon).Named("tn", "t", "ton", "short ton", "US ton"),
+		LongTons:  Rational(gramsPerLongTon).Named("LT", "ton", "long ton", "imperial ton", "UK ton"),
+	},
+
+	Time: {
+		Seconds: Rational("1").Named("s", "sec", "secs", "second").WithPrefixes(SIPrefixed),
+		Minutes: Rational(secondsPerMinute).Named("min", "mins", "minute"),
+		Hours:   Rational(secondsPerHour).Named("h", "hr", "hrs", "hour"),
+		Days:    Rational(secondsPerDay).Named("d", "day"),
+		Weeks:   Rational(secondsPerWeek).Named("wk", "week"),
+
+		GregorianMonths: Rational(secondsPerGregorianMonth).Named("mo", "month", "months").Approximated(),
+		JulianMonths:    Rational(secondsPerJulianMonth).Named("julian month").Approximated(),
+		GregorianYears:  Rational(secondsPerGregorianYear).Named("yr", "y", "year", "years").Approximated(),
+		JulianYears:     Rational(secondsPerJulianYear).Named("a", "julian year").Approximated(),
+	},
+
+	Force: {
+		Newtons:     Rational("1").Named("N", "newton").WithPrefixes(SIPrefixed),
+		PoundsForce: Rational(newtonsPerPoundForce).Named("lbf", "pound-force", "pound force"),
+	},
+
+	Energy: {
+		Joules:              Rational("1").Named("J", "joule").WithPrefixes(SIPrefixed),
+		WattHours:           Rational(secondsPerHour).Named("Wh", "watt-hour").WithPrefixes(SIPrefixed),
+		Electronvolts:       Rational(joulesPerElectronvolt).Named("eV", "electronvolt", "electron-volt").WithPrefixes(SIPrefixed),
+		FootPoundsForce:     Product(metersPerFoot, newtonsPerPoundForce).Named("ft·lbf", "foot-pound", "foot-pound-force").WithAlternates("ft-lbf", "ft*lbf", "ft lbf"),
+		Calories:            Rational(joulesPerCalorie).Named("cal", "calorie").WithPrefixes(SIPrefixed),
+		Kilocalories:        Product(joulesPerCalorie, "1000").Named("kcal", "Cal", "kilocalorie", "food calorie"),
+		BritishThermalUnits: Rational(joulesPerBTU).Named("BTU", "Btu", "british thermal unit"),
+		Therms:              Rational(joulesPerTherm).Named("thm", "therm"),
+	},
+
+	Volume: {
+		CubicMeters:      Rational("1").Named("m³", "m3", "cubic meter", "cubic metre", "cubic metres"),
+		Liters:           Rational("1/1000").Named("L", "liter", "litre", "litres").WithAlternates("l", "ℓ").WithPrefixes(SIPrefixed),
+		CubicCentimeters: Rational("1/1000000").Named("cm³", "cm3", "cc", "cubic centimeter", "cubic centimetre"),
+		CubicInches:      Product(metersPerInch, metersPerInch, metersPerInch).Named("in³", "in3", "cu in", "cubic inch"),
+		CubicFeet:        Product(metersPerFoot, metersPerFoot, metersPerFoot).Named("ft³", "ft3", "cu ft", "cubic foot"),
+
+		USTeaspoons:   Rational(cubicMetersPerUSTeaspoon).Named("US tsp", "tsp", "teaspoon", "teaspoons", "US teaspoon"),
+		USTablespoons: Rational(cubicMetersPerUSTablespoon).Named("US tbsp", "tbsp", "tablespoon", "tablespoons", "US tablespoon"),
+		USFluidOunces: Rational(cubicMetersPerUSFluidOunce).Named("US fl oz", "fl oz", "fluid ounce", "fluid ounces", "US fluid ounce"),
+		USCups:        Rational(cubicMetersPerUSCup).Named("US cup", "cup", "cups", "US cups"),
+		USPints:       Rational(cubicMetersPerUSPint).Named("US pt", "pt", "pint", "pints", "US pint"),
+		USQuarts:      Rational(cubicMetersPerUSQuart).Named("US qt", "qt", "quart", "quarts", "US quart"),
+		USGallons:     Rational(cubicMetersPerUSGallon).Named("US gal", "gal", "gallon", "gallons", "US gallon"),
+
+		ImperialTeaspoons:   Rational(cubicMetersPerImperialTeaspoon).Named("imp tsp", "tsp", "teaspoon", "teaspoons", "imperial teaspoon"),
+		ImperialTablespoons: Rational(cubicMetersPerImperialTablespoon).Named("imp tbsp", "tbsp", "tablespoon", "tablespoons", "imperial tablespoon"),
+		ImperialFluidOunces: Rational(cubicMetersPerImperialFluidOunce).Named("imp fl oz", "fl oz", "fluid ounce", "fluid ounces", "imperial fluid ounce"),
+		ImperialCups:        Rational(cubicMetersPerImperialCup).Named("imp cup", "cup", "cups", "imperial cups"),
+		ImperialPints:       Rational(cubicMetersPerImperialPint).Named("imp pt", "pt", "pint", "pints", "imperial pint"),
+		ImperialQuarts:      Rational(cubicMetersPerImperialQuart).Named("imp qt", "qt", "quart", "quarts", "imperial quart"),
+		ImperialGallons:     Rational(cubicMetersPerImperialGallon).Named("imp gal", "gal", "gallon", "gallons", "imperial gallon"),
+	},
+
+	Area: {
+		SquareMillimeters: Product("0.001", "0.001").Named("mm²", "mm2", "sq mm", "square millimeter", "square millimetre"),
+		SquareCentimeters: Product("0.01", "0.01").Named("cm²", "cm2", "sq cm", "square centimeter", "square centimetre"),
+		SquareMeters:      Rational("1").Named("m²", "m2", "sq m", "square meter", "square metre", "square metres"),
+		Ares:              Product("10", "10").Named("a", "are"),
+		Hectares:          Product("100", "100").Named("ha", "hectare"),
+		SquareKilometers:  Product("1000", "1000").Named("km²", "km2", "sq km", "square kilometer", "square kilometre"),
+		SquareInches:      Product(metersPerInch, metersPerInch).Named("in²", "in2", "sq in", "square inch"),
+		SquareFeet:        Product(metersPerFoot, metersPerFoot).Named("ft²", "ft2", "sq ft", "square foot"),
+		SquareYards:       Product(metersPerYard, metersPerYard).Named("yd²", "yd2", "sq yd", "square yard"),
+		Acres:             Product(metersPerFurlong, metersPerChain).Named("ac", "acre"), // 1 furlong by 1 chain
+		SquareMiles:       Product(metersPerMile, metersPerMile).Named("mi²", "mi2", "sq mi", "square mile"),
+	},
+
+	Speed: {
+		MetersPerSecond:   Rational("1").Named("m/s", "mps", "meter per second", "metre per second", "metres per second"),
+		KilometersPerHour: Rational("1000").Per(secondsPerHour).Named("km/h", "kph", "kmh", "km/hr", "kilometer per hour", "kilometre per hour", "kilometres per hour"),
+		FeetPerSecond:     Rational(metersPerFoot).Named("ft/s", "fps", "foot per second"),
+		MilesPerHour:      Rational(metersPerMile).Per(secondsPerHour).Named("mph", "mi/h", "mile per hour"),
+		Knots:             Rational(metersPerNauticalMile).Per(secondsPerHour).Named("kn", "kt", "kts", "knot"),
+		Mach:              Linear(metersPerSecondPerMach).Named("Ma", "Mach").Approximated(),
+		SpeedOfLight:      Rational(metersPerSecondPerSpeedOfLight).Named("c₀", "speed of light", "lightspeed"),
+	},
+
+	Data: {
+		Bits:  Rational("1").Named("bit").WithAlternates("b").WithPrefixes(SIMultiplePrefixed | BinaryPrefixed),
+		Bytes: Rational(bitsPerByte).Named("B", "byte", "octet").WithPrefixes(SIMultiplePrefixed | BinaryPrefixed),
+
+		// Listed so that both claim "KB"
+		Kilobytes: Rational(bitsPerKilobyte).Named("kB", "KB", "kilobyte"),
+		Kibibytes: Rational(bitsPerKibibyte).Named("KiB", "KB", "kibibyte"),
+	},
+
+	DataRate: {
+		BitsPerSecond:  Rational("1").Named("bit/s", "bit per second").WithAlternates("bps", "b/s").WithPrefixes(SIMultiplePrefixed | BinaryPrefixed),
+		BytesPerSecond: Rational(bitsPerByte).Named("B/s", "byte per second").WithAlternates("Bps").WithPrefixes(SIMultiplePrefixed | BinaryPrefixed),
+	},
+
+	Pressure: {
+		Pascals:              Rational("1").Named("Pa", "pascal").WithPrefixes(SIPrefixed),
+		Bars:                 Rational("100000").Named("bar").WithPrefixes(SIPrefixed),
+		Atmospheres:          Rational(pascalsPerAtmosphere).Named("atm", "atmosphere"),
+		PoundsPerSquareInch:  Rational(newtonsPerPoundForce).Per(metersPerInch).Per(metersPerInch).Named("psi", "lbf/in²", "pound per square inch"),
+		MillimetersOfMercury: Rational(pascalsPerMillimeterOfMercury).Named("mmHg", "millimeter of mercury", "millimetre of mercury"),
+		InchesOfMercury:      Product(pascalsPerMillimeterOfMercury, "25.4").Named("inHg", "inch of mercury"),
+		Torr:                 Rational(pascalsPerTorr).Named("Torr"),
+		CentimetersOfWater:   Rational(pascalsPerCentimeterOfWater).Named("cmH2O", "cmH₂O", "centimeter of water", "centimetre of water"),
+	},
+
+	Power: {
+		Watts:               Rational("1").Named("W", "watt").WithPrefixes(SIPrefixed),
+		BTUsPerHour:         Rational(joulesPerBTU).Per(secondsPerHour).Named("BTU/h", "Btu/h", "BTU/hr", "btu per hour"),
+		MetricHorsepower:    Product(kilogramForceMetersPerSecondPerMetricHorsepower, newtonsPerKilogramForce).Named("PS", "hp(M)", "CV", "metric hp"),
+		Horsepower:          Product(footPoundsForcePerSecondPerHorsepower, metersPerFoot, newtonsPerPoundForce).Named("hp", "hp(I)", "mechanical horsepower"),
+		TonsOfRefrigeration: Product(btusPerHourPerTonOfRefrigeration, joulesPerBTU).Per(secondsPerHour).Named("TR", "RT", "ton of refrigeration"),
+	},
+
+	Angle: {
+		Degrees:    Rational("1").Named("°", "deg", "degree"),
+		Arcminutes: Rational("1/60").Named("arcmin", "arcminute", "minute of arc"),
+		Arcseconds: Rational("1/3600").Named("arcsec", "arcsecond", "second of arc"),
+		Gradians:   Rational(degreesPerGradian).Named("gon", "grad", "gradian", "grade"),
+		Turns:      Rational(degreesPerTurn).Named("tr", "rev", "turn", "revolution"),
+		Radians:    Linear(180/math.Pi).Named("rad", "radian").WithPrefixes(SISubmultiplePrefixed),
+	},
+
+	FuelEconomy: {
+		KilometersPerLiter:     Rational("1").Named("km/L", "km/l", "kmpl", "kilometer per liter", "kilometre per litre"),
+		LitersPer100Kilometers: Reciprocal("100").Named("L/100km", "l/100km", "L/100 km", "liters per 100 km", "litres per 100 km"),
+		// m/m³ are a millionth of km/L
+		MilesPerUSGallon:       Rational(metersPerMile).Per(cubicMetersPerUSGallon).Per("1000000").Named("mpg (US)", "mpg", "US mpg", "miles per gallon"),
+		MilesPerImperialGallon: Rational(metersPerMile).Per(cubicMetersPerImperialGallon).Per("1000000").Named("mpg (imp)", "mpg", "imperial mpg", "miles per gallon"),
+	},
+
+	// Currencies come from the exchange rates in use, see LoadRates
+	Currency: {},
+
+	ShoeSize: {
+		Mondopoint: Rational("1").Named("MP", "mondopoint size", "foot length"),
+		USShoeSize: Tabulated(shoeSizes[USShoeSize], Rational("1")).Named("US shoe", "US shoe size", "US men's shoe size"),
+		UKShoeSize: Tabulated(shoeSizes[UKShoeSize], Rational("1")).Named("UK shoe", "UK shoe size"),
+		EUShoeSize: Tabulated(shoeSizes[EUShoeSize], Rational("1")).Named("EU shoe", "EU shoe size", "Paris point"),
+		JPShoeSize: Tabulated(shoeSizes[JPShoeSize], Rational("1")).Named("JP shoe", "JP shoe size", "Japanese shoe size"),
+	},
+
+	RingSize: {
+		ISORingSize: Rational("1").Named("ISO ring", "ISO ring size", "EU ring", "EU ring size", "inner circumference"),
+		USRingSize:  Tabulated(ringSizes[USRingSize], Rational("1")).Named("US ring", "US ring size", "Canadian ring size"),
+		JPRingSize:  Tabulated(ringSizes[JPRingSize], Rational("1")).Named("JP ring", "JP ring size", "Japanese ring size"),
+	},
+}
+
+// Converter returns the function converting values of fromUnit to toUnit
+func Converter(unitType UnitType, fromUnit, toUnit Unit) (ConverterFunc, error) {
+	from, to, err := lookupPair(unitType, fromUnit, toUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(value float64) float64 {
+		return to.FromBase(from.ToBase(value))
+	}, nil
+}
+
+// lookupPair finds the definitions of fromUnit and toUnit within unitType
+func lookupPair(unitType UnitType, fromUnit, toUnit Unit) (UnitDefinition, UnitDefinition, error) {
+	from, err := resolve(unitType, fromUnit)
+	if err != nil {
+		return UnitDefinition{}, UnitDefinition{}, err
+	}
+
+	to, err := resolve(unitType, toUnit)
+	if err != nil {
+		return UnitDefinition{}, UnitDefinition{}, err
+	}
+
+	return from, to, nil
+}
+
+// resolve finds the definition of unit within unitType, accepting symbols and aliases
+func resolve(unitType UnitType, unit Unit) (UnitDefinition, error) {
+	if _, ok := Registry[unitType]; !ok {
+		return UnitDefinition{}, &UnitError{UnitType: unitType, Err: ErrUnknownUnitType}
+	}
+
+	if definition, ok := Definition(unitType, unit); ok {
+		return definition, nil
+	}
+
+	found, err := LookupIn(unitType, string(unit))
+	if errors.Is(err, ErrAmbiguousUnit) {
+		return UnitDefinition{}, err
+	}
+	if err != nil {
+		return UnitDefinition{}, unknownUnitError(unitType, unit)
+	}
+
+	definition, _ := Definition(unitType, found)
+	return definition, nil
+}
+
+// prepare finds the definitions of fromUnit and toUnit and adjusts them to the
+// options, as gauge readings, exchange rates, intervals or context parameters
+// require. Convert and ConvertRat share it, so that every option applies to
+// both; each then checks the value against the domain of fromUnit.
+func (o ConvertOptions) prepare(unitType UnitType, fromUnit, toUnit Unit) (UnitDefinition, UnitDefinition, error) {
+	var from, to UnitDefinition
+	var err error
+	if unitType == Currency {
+		// Rates may be reloaded at any time, so a conversion takes them once
+		if o.rateTable == nil {
+			o.rateTable = CurrentRates()
+		}
+		from, to, err = o.rateTable.lookupPair(fromUnit, toUnit)
+	} else {
+		from, to, err = lookupPair(unitType, fromUnit, toUnit)
+	}
+	if err != nil {
+		return from, to, err
+	}
+
+	from, to, err = o.gauge(unitType, from, to)
+	if err != nil {
+		return from, to, err
+	}
+
+	from, to, err = o.rates(unitType, from, to)
+	if err != nil {
+		return from, to, err
+	}
+
+	from, to, err = o.interval(unitType, from, to)
+	if err != nil {
+		return from, to, err
+	}
+
+	return o.scale(fromUnit, toUnit, from, to)
+}
+
+// Convert performs a conversion between two units of the same type.
+// Values outside the domain of the unit type are rejected with a *DomainError,
+// and the result is rounded to DefaultDecimals places unless opts say otherwise.
+func Convert(unitType UnitType, fromUnit, toUnit Unit, value float64, opts ...ConvertOption) (float64, error) {
+	result, _, err := convert(unitType, fromUnit, toUnit, value, newConvertOptions(opts...))
+	return result, err
+}
+
+// convert is Convert with its options applied. It also reports whether the
+// value, or its conversion, fell between the entries of a table-backed unit.
+func convert(unitType UnitType, fromUnit, toUnit Unit, value float64, options ConvertOptions) (float64, bool, error) {
+	if result, ok, err := options.convertSubstance(unitType, fromUnit, toUnit, value); ok || err != nil {
+		return result, false, err
+	}
+
+	from, to, err := options.prepare(unitType, fromUnit, toUnit)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// Intervals are differences, which absolute zero does not bound
+	if !options.Interval {
+		if err := checkDomain(unitType, fromUnit, from, value, options.Signed); err != nil {
+			return 0, false, err
+		}
+	}
+
+	result := value
+	between := false
+	switch {
+	case from.Table != nil || to.Table != nil:
+		result, between, err = options.convertTable(unitType, fromUnit, toUnit, from, to, value)
+		if err != nil {
+			return 0, false, err
+		}
+	case fromUnit != toUnit || options.GaugeFrom != options.GaugeTo:
+		result = to.FromBase(from.ToBase(value))
+	}
+
+	if err := checkFinite(unitType, fromUnit, toUnit, value, result); err != nil {
+		return 0, false, err
+	}
+
+	result, err = options.normalize(unitType, to, result)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// Rounding may carry an angle onto the end of its range, as 359.999° to 360°
+	result, err = options.normalize(unitType, to, options.Round(result))
+	if err != nil {
+		return 0, false, err
+	}
+
+	return result, between, nil
+}